@@ -14,14 +14,13 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
-	"strings"
 	"time"
 
-	"github.com/vmware/govmomi/license"
 	"github.com/vmware/govmomi/sts"
 	"github.com/vmware/govmomi/vapi/rest"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/globalpermission"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/pbm"
@@ -57,6 +56,10 @@ type Client struct {
 	// The SSO client
 	ssoClient *ssoadmin.Client
 
+	// The client for global permissions, which are only exposed through the
+	// inventory service of vcenter
+	globalPermissionClient *globalpermission.Client
+
 	// client timeout for certain operations
 	timeout time.Duration
 }
@@ -105,7 +108,6 @@ type Config struct {
 	DebugPathRun    string
 	VimSessionPath  string
 	RestSessionPath string
-	LicenseKey      string
 	KeepAlive       int
 	APITimeout      time.Duration
 }
@@ -139,7 +141,6 @@ func NewConfig(d *schema.ResourceData) (*Config, error) {
 		Persist:         d.Get("persist_session").(bool),
 		VimSessionPath:  d.Get("vim_session_path").(string),
 		RestSessionPath: d.Get("rest_session_path").(string),
-		LicenseKey:      d.Get("license_key").(string),
 		KeepAlive:       d.Get("vim_keep_alive").(int),
 		APITimeout:      timeout,
 	}
@@ -254,6 +255,7 @@ func (c *Config) Client() (*Client, error) {
 		}
 
 		client.ssoClient = ssoclient
+		client.globalPermissionClient = globalpermission.NewClient(client.vimClient.Client, c.User, c.Password)
 	} else {
 		log.Printf("[DEBUG] Connected endpoint does not support SSO service")
 	}
@@ -266,58 +268,11 @@ func (c *Config) Client() (*Client, error) {
 		return nil, fmt.Errorf("error persisting REST session to disk: %s", err)
 	}
 
-	if client.vimClient.ServiceContent.About.ApiType == "VirtualCenter" &&
-		c.LicenseKey != "" {
-		if err = c.applyVCenterLicense(client.vimClient); err != nil {
-			return nil, fmt.Errorf("error trying to apply vcenter license: %s", err)
-		}
-	}
-
 	client.timeout = c.APITimeout
 
 	return client, nil
 }
 
-// applyVCenterLicense will attempt to apply vcenter license
-func (c *Config) applyVCenterLicense(client *govmomi.Client) error {
-	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
-	defer cancel()
-
-	lm := license.NewManager(client.Client)
-	licenseList, err := lm.List(ctx)
-	if err != nil {
-		return fmt.Errorf("error trying to get license list: %s", err)
-	}
-
-	foundLicense := false
-
-	for _, v := range licenseList {
-		if strings.EqualFold(v.LicenseKey, c.LicenseKey) {
-			foundLicense = true
-			break
-		}
-	}
-
-	if !foundLicense {
-		if _, err = lm.Add(ctx, c.LicenseKey, nil); err != nil {
-			return fmt.Errorf("error trying to add vcenter license to license manager: %s", err)
-		}
-	}
-
-	lam, err := lm.AssignmentManager(ctx)
-	if err != nil {
-		return fmt.Errorf("error trying to retrieve license assignment: %s", err)
-	}
-
-	log.Printf("[INFO] applying license key to vcenter: %s", c.VSphereServer)
-
-	if _, err = lam.Update(ctx, client.ServiceContent.About.InstanceUuid, c.LicenseKey, ""); err != nil {
-		return fmt.Errorf("error trying to update license key for vcenter host %s: %s", c.VSphereServer, err)
-	}
-
-	return nil
-}
-
 func (c *Config) restURL() (*cache.Session, error) {
 	u, err := url.Parse("https://" + c.VSphereServer)
 	if err != nil {
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/utils"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func dataSourceVSphereEffectivePrivileges() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVSphereEffectivePrivilegesRead,
+
+		Schema: map[string]*schema.Schema{
+			"user_or_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "User or group to check the privileges of in the form 'DOMAIN\\name'",
+			},
+			"entity": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Entities to check the privileges on",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The managed object id or uuid of the entity",
+						},
+						"entity_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The entity managed object type",
+						},
+					},
+				},
+			},
+			"privileges": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Privileges to check.  When not set all privileges held on the entities are returned",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"entity_privileges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Privileges held on each entity in the order of entity",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The managed object id of the entity",
+						},
+						"entity_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The entity managed object type",
+						},
+						"granted_privileges": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Privileges held on the entity",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"missing_privileges": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Checked privileges not held on the entity",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"all_granted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether all checked privileges are held on all entities",
+			},
+		},
+	}
+}
+
+func dataSourceVSphereEffectivePrivilegesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	if client.ServiceContent.AuthorizationManager == nil {
+		return fmt.Errorf("authorization manager is not available")
+	}
+
+	principal := d.Get("user_or_group").(string)
+	privileges := structure.SliceInterfacesToStrings(d.Get("privileges").([]interface{}))
+
+	log.Printf("[DEBUG] Reading effective privileges of %s", principal)
+
+	var entities []types.ManagedObjectReference
+	for _, entity := range d.Get("entity").([]interface{}) {
+		entityType := entity.(map[string]interface{})["entity_type"].(string)
+		entityMoid, err := utils.GetMoid(client, entityType, entity.(map[string]interface{})["entity_id"].(string))
+		if err != nil {
+			return err
+		}
+
+		entities = append(entities, types.ManagedObjectReference{Type: entityType, Value: entityMoid})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	authorizationManager := object.NewAuthorizationManager(client.Client)
+	granted := make(map[types.ManagedObjectReference][]string)
+	missing := make(map[types.ManagedObjectReference][]string)
+
+	if len(privileges) > 0 {
+		results, err := authorizationManager.HasUserPrivilegeOnEntities(ctx, entities, principal, privileges)
+		if err != nil {
+			return fmt.Errorf("error while checking privileges of %s %s", principal, err)
+		}
+
+		for _, result := range results {
+			for _, availability := range result.PrivAvailability {
+				if availability.IsGranted {
+					granted[result.Entity] = append(granted[result.Entity], availability.PrivId)
+				} else {
+					missing[result.Entity] = append(missing[result.Entity], availability.PrivId)
+				}
+			}
+		}
+	} else {
+		results, err := authorizationManager.FetchUserPrivilegeOnEntities(ctx, entities, principal)
+		if err != nil {
+			return fmt.Errorf("error while fetching privileges of %s %s", principal, err)
+		}
+
+		for _, result := range results {
+			granted[result.Entity] = append(granted[result.Entity], result.Privileges...)
+		}
+	}
+
+	allGranted := true
+	entityPrivileges := make([]interface{}, 0, len(entities))
+	for _, entity := range entities {
+		sort.Strings(granted[entity])
+		sort.Strings(missing[entity])
+
+		if len(missing[entity]) > 0 {
+			allGranted = false
+		}
+
+		entityPrivileges = append(entityPrivileges, map[string]interface{}{
+			"entity_id":          entity.Value,
+			"entity_type":        entity.Type,
+			"granted_privileges": structure.SliceStringsToInterfaces(granted[entity]),
+			"missing_privileges": structure.SliceStringsToInterfaces(missing[entity]),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", principal, vsphereEffectivePrivilegesEntitiesID(entities)))
+	_ = d.Set("all_granted", allGranted)
+	return d.Set("entity_privileges", entityPrivileges)
+}
+
+func vsphereEffectivePrivilegesEntitiesID(entities []types.ManagedObjectReference) string {
+	ids := make([]string, 0, len(entities))
+	for _, entity := range entities {
+		ids = append(ids, entity.Value)
+	}
+
+	return strings.Join(ids, ",")
+}
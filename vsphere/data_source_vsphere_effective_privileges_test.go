@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/testhelper"
+)
+
+func TestAccDataSourceVSphereEffectivePrivileges_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccSkipIfEsxi(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereEffectivePrivilegesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vsphere_effective_privileges.checked", "all_granted", "true"),
+					resource.TestCheckResourceAttr("data.vsphere_effective_privileges.checked", "entity_privileges.0.entity_type", "Datacenter"),
+					resource.TestCheckResourceAttr("data.vsphere_effective_privileges.checked", "entity_privileges.0.granted_privileges.#", "2"),
+					resource.TestCheckResourceAttr("data.vsphere_effective_privileges.checked", "entity_privileges.0.missing_privileges.#", "0"),
+					resource.TestCheckTypeSetElemAttr("data.vsphere_effective_privileges.all", "entity_privileges.0.granted_privileges.*", "Datacenter.Move"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereEffectivePrivilegesConfig() string {
+	return fmt.Sprintf(`
+%s
+
+	data "vsphere_effective_privileges" "checked" {
+		user_or_group = "VSPHERE.LOCAL\\Administrator"
+		privileges    = ["Datacenter.Move", "VirtualMachine.Provisioning.Clone"]
+
+		entity {
+			entity_id   = data.vsphere_datacenter.rootdc1.id
+			entity_type = "Datacenter"
+		}
+	}
+
+	data "vsphere_effective_privileges" "all" {
+		user_or_group = "VSPHERE.LOCAL\\Administrator"
+
+		entity {
+			entity_id   = data.vsphere_datacenter.rootdc1.id
+			entity_type = "Datacenter"
+		}
+	}
+`,
+		testhelper.ConfigDataRootDC1(),
+	)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/globalpermission"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
+)
+
+const vsphereGlobalPermissionsID = "tf-global-permissions"
+
+func dataSourceVSphereGlobalPermissions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVSphereGlobalPermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"permissions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Global permissions of all users and groups",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_or_group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User or group having access",
+						},
+						"is_group": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether user_or_group refers to a group",
+						},
+						"role_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Reference to the role providing the access",
+						},
+						"role_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "References to all roles providing the access",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"propagate": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the permission propagates down the hierarchy",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVSphereGlobalPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).globalPermissionClient
+	if err := globalpermission.CheckClient(client); err != nil {
+		return err
+	}
+
+	permissions, err := client.List()
+	if err != nil {
+		return err
+	}
+
+	result := make([]interface{}, 0, len(permissions))
+	for _, permission := range permissions {
+		roleIDs := make([]string, 0, len(permission.RoleIDs))
+		for _, id := range permission.RoleIDs {
+			roleIDs = append(roleIDs, strconv.FormatInt(id, 10))
+		}
+
+		roleID := ""
+		if len(roleIDs) > 0 {
+			roleID = roleIDs[0]
+		}
+
+		result = append(result, map[string]interface{}{
+			"user_or_group": permission.Principal,
+			"is_group":      permission.Group,
+			"role_id":       roleID,
+			"role_ids":      structure.SliceStringsToInterfaces(roleIDs),
+			"propagate":     permission.Propagate,
+		})
+	}
+
+	d.SetId(vsphereGlobalPermissionsID)
+	return d.Set("permissions", result)
+}
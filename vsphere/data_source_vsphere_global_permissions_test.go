@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVSphereGlobalPermissions_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccSkipIfEsxi(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereGlobalPermissionsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vsphere_global_permissions.permissions", "permissions.0.user_or_group"),
+					resource.TestCheckResourceAttrSet("data.vsphere_global_permissions.permissions", "permissions.0.role_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereGlobalPermissionsConfig() string {
+	return `
+	data "vsphere_global_permissions" "permissions" {}
+	`
+}
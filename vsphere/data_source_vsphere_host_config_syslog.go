@@ -37,6 +37,70 @@ func dataSourceVSphereHostConfigSyslog() *schema.Resource {
 				Computed:    true,
 				Description: "The log level to output logs",
 			},
+			"default_rotate": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Default number of rotated log files to keep",
+			},
+			"default_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Default size in KiB of log files before they are rotated",
+			},
+			"log_dir": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Datastore path of the directory logs are output to",
+			},
+			"log_dir_unique": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether logs are placed in a subdirectory of log_dir named after the host",
+			},
+			"audit_record_storage_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether audit records are stored locally",
+			},
+			"audit_record_storage_capacity": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Capacity in MiB of the local audit record storage",
+			},
+			"audit_record_storage_directory": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Datastore path of the directory audit records are stored in",
+			},
+			"audit_record_remote_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether audit records are forwarded to the remote log host",
+			},
+			"logger": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Rotation settings of every logger on the host",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the logger",
+						},
+						"rotate": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of rotated log files kept for the logger",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Size in KiB of the log file of the logger before it is rotated",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -50,7 +114,7 @@ func dataSourceVSphereHostConfigSyslogRead(d *schema.ResourceData, meta interfac
 
 	log.Printf("[INFO] reading syslog settings from data source for host '%s'", host.Name())
 
-	if err = hostconfig.HostConfigSyslogRead(d, client, host); err != nil {
+	if err = hostconfig.HostConfigSyslogRead(d, client, host, hostconfig.SyslogLoggersAll); err != nil {
 		return err
 	}
 
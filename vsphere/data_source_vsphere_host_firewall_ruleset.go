@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
+)
+
+func dataSourceVSphereHostFirewallRuleset() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVSphereHostFirewallRulesetRead,
+
+		Schema: map[string]*schema.Schema{
+			"host_system_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Host id of machine to gather firewall rulesets of",
+				ExactlyOneOf: []string{"hostname"},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Hostname of machine to gather firewall rulesets of",
+			},
+			"incoming_blocked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether incoming traffic not matched by an enabled ruleset is blocked",
+			},
+			"outgoing_blocked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether outgoing traffic not matched by an enabled ruleset is blocked",
+			},
+			"ruleset": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The firewall rulesets of the host",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key of the ruleset",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display label of the ruleset",
+						},
+						"service": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key of the host service that uses the ruleset",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the ports of the ruleset are open",
+						},
+						"required": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the ruleset is required by the host and can not be disabled",
+						},
+						"allowed_hosts": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "IP addresses and networks the ruleset is restricted to.  Empty when all addresses are allowed",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The ports of the ruleset",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"port": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The port number",
+									},
+									"end_port": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The last port number of a port range",
+									},
+									"direction": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The direction of the port, 'inbound' or 'outbound'",
+									},
+									"protocol": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The protocol of the port, 'tcp' or 'udp'",
+									},
+									"port_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Whether the port is the 'src' or 'dst' port",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVSphereHostFirewallRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] reading firewall rulesets for host '%s'", host.Name())
+
+	info, err := hostconfig.GetHostFirewallInfo(client, host)
+	if err != nil {
+		return err
+	}
+
+	rulesets := make([]interface{}, 0, len(info.Ruleset))
+	for _, ruleset := range info.Ruleset {
+		rules := make([]interface{}, 0, len(ruleset.Rule))
+		for _, rule := range ruleset.Rule {
+			rules = append(rules, map[string]interface{}{
+				"port":      int(rule.Port),
+				"end_port":  int(rule.EndPort),
+				"direction": string(rule.Direction),
+				"protocol":  rule.Protocol,
+				"port_type": string(rule.PortType),
+			})
+		}
+
+		rulesets = append(rulesets, map[string]interface{}{
+			"key":           ruleset.Key,
+			"label":         ruleset.Label,
+			"service":       ruleset.Service,
+			"enabled":       ruleset.Enabled,
+			"required":      ruleset.Required,
+			"allowed_hosts": structure.SliceStringsToInterfaces(hostconfig.FirewallIPListToStrings(ruleset.AllowedHosts)),
+			"rule":          rules,
+		})
+	}
+
+	d.SetId(hr.Value)
+	d.Set(hr.IDName, hr.Value)
+	d.Set("incoming_blocked", structure.BoolNilFalse(info.DefaultPolicy.IncomingBlocked))
+	d.Set("outgoing_blocked", structure.BoolNilFalse(info.DefaultPolicy.OutgoingBlocked))
+	d.Set("ruleset", rulesets)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/testhelper"
+)
+
+func TestAccDataSourceVSphereHostFirewallRuleset_basic(t *testing.T) {
+	resourceName := "data.vsphere_host_firewall_ruleset.h1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariablesF(t, []string{"TF_VAR_VSPHERE_DATACENTER", "TF_VAR_VSPHERE_ESXI1"})
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereHostFirewallRulesetConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile("^host-")),
+					resource.TestCheckResourceAttr(resourceName, "incoming_blocked", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ruleset.*", map[string]string{
+						"key":      "sshServer",
+						"required": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ruleset.*.rule.*", map[string]string{
+						"port":      "22",
+						"direction": "inbound",
+						"protocol":  "tcp",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereHostFirewallRulesetConfig() string {
+	return fmt.Sprintf(
+		`
+		%s
+
+		data "vsphere_host_firewall_ruleset" "h1" {
+			host_system_id = data.vsphere_host.roothost1.id
+		}
+		`,
+		testhelper.CombineConfigs(
+			testhelper.ConfigDataRootDC1(),
+			testhelper.ConfigDataRootHost1(),
+		),
+	)
+}
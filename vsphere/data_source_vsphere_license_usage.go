@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi/license"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	vsphereLicenseUsageID = "tf-license-usage"
+
+	licenseExpirationDateProperty = "expirationDate"
+)
+
+func dataSourceVSphereLicenseUsage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVSphereLicenseUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"edition_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return licenses of this edition",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Only return licenses that have all of these labels",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"licenses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Usage of the matching licenses",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"license_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"edition_key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"total": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total capacity of the license in cost units",
+						},
+						"used": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Used capacity of the license in cost units",
+						},
+						"available": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Capacity of the license left in cost units",
+						},
+						"cost_unit": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Unit the capacity is counted in such as cpuPackage or vm",
+						},
+						"expiration_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiration date of the license in RFC3339 format.  Empty for licenses that don't expire",
+						},
+						"assigned_entities": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Assets the license is assigned to",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"entity_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"entity_display_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVSphereLicenseUsageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	manager := license.NewManager(client.Client)
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	infos, err := manager.List(ctx)
+	if err != nil {
+		return fmt.Errorf("error while listing licenses. Error: %s", err)
+	}
+
+	// assignments are only tracked by vcenter
+	assigned := make(map[string][]interface{})
+	if client.IsVC() {
+		lam, err := manager.AssignmentManager(ctx)
+		if err != nil {
+			return fmt.Errorf("error while accessing License Assignment Manager endpoint. Error: %s", err)
+		}
+
+		assignments, err := lam.QueryAssigned(ctx, "")
+		if err != nil {
+			return fmt.Errorf("error while querying license assignments. Error: %s", err)
+		}
+
+		for _, assignment := range assignments {
+			key := assignment.AssignedLicense.LicenseKey
+			assigned[key] = append(assigned[key], map[string]interface{}{
+				"entity_id":           assignment.EntityId,
+				"entity_display_name": assignment.EntityDisplayName,
+			})
+		}
+	}
+
+	edition := d.Get("edition_key").(string)
+	labels := d.Get("labels").(map[string]interface{})
+
+	var licenses []interface{}
+	for _, info := range infos {
+		if info.LicenseKey == licenseEvaluationKey {
+			continue
+		}
+
+		if edition != "" && info.EditionKey != edition {
+			continue
+		}
+
+		if !vsphereLicenseUsageHasLabels(info, labels) {
+			continue
+		}
+
+		log.Printf("[DEBUG] reading usage of license %s", info.Name)
+
+		licenses = append(licenses, map[string]interface{}{
+			"license_key":       info.LicenseKey,
+			"name":              info.Name,
+			"edition_key":       info.EditionKey,
+			"labels":            keyValuesToMap(info.Labels),
+			"total":             int(info.Total),
+			"used":              int(info.Used),
+			"available":         int(info.Total - info.Used),
+			"cost_unit":         info.CostUnit,
+			"expiration_date":   vsphereLicenseUsageExpirationDate(info),
+			"assigned_entities": assigned[info.LicenseKey],
+		})
+	}
+
+	d.SetId(vsphereLicenseUsageID)
+	return d.Set("licenses", licenses)
+}
+
+func vsphereLicenseUsageHasLabels(info types.LicenseManagerLicenseInfo, labels map[string]interface{}) bool {
+	licenseLabels := keyValuesToMap(info.Labels)
+
+	for key, value := range labels {
+		if licenseLabels[key] != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func vsphereLicenseUsageExpirationDate(info types.LicenseManagerLicenseInfo) string {
+	for _, property := range info.Properties {
+		if property.Key != licenseExpirationDateProperty {
+			continue
+		}
+
+		if date, ok := property.Value.(time.Time); ok {
+			return date.Format(time.RFC3339)
+		}
+	}
+
+	return ""
+}
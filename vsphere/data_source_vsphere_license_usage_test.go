@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVSphereLicenseUsage_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccDataSourceVSphereLicensePreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereLicenseUsageConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vsphere_license_usage.labeled", "licenses.#", "1"),
+					resource.TestCheckResourceAttr("data.vsphere_license_usage.labeled", "licenses.0.license_key", os.Getenv("TF_VAR_VSPHERE_LICENSE")),
+					resource.TestCheckResourceAttrPair(
+						"data.vsphere_license_usage.labeled", "licenses.0.edition_key",
+						"vsphere_license.license", "edition_key",
+					),
+					resource.TestCheckResourceAttrSet("data.vsphere_license_usage.labeled", "licenses.0.cost_unit"),
+					resource.TestCheckResourceAttr("data.vsphere_license_usage.unmatched", "licenses.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereLicenseUsageConfig() string {
+	return fmt.Sprintf(`
+resource "vsphere_license" "license" {
+  license_key = "%s"
+
+  labels = {
+    terraform-test = "license-usage"
+  }
+}
+
+data "vsphere_license_usage" "labeled" {
+  labels = {
+    terraform-test = vsphere_license.license.labels["terraform-test"]
+  }
+}
+
+data "vsphere_license_usage" "unmatched" {
+  edition_key = vsphere_license.license.edition_key
+
+  labels = {
+    terraform-test = "unmatched"
+  }
+}
+`, os.Getenv("TF_VAR_VSPHERE_LICENSE"))
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/administrationroles"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
+)
+
+func dataSourceVSpherePrivileges() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVSpherePrivilegesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Group prefix such as 'VirtualMachine.Config' or glob pattern such as 'VirtualMachine.*' the privileges must match",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the matching privileges",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"privileges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching privileges",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the privilege such as 'VirtualMachine.Config.AddNewDisk'",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the privilege within its group",
+						},
+						"group": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Group of the privilege",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Display label of the privilege",
+						},
+						"summary": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the privilege",
+						},
+						"on_parent": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the privilege is checked on the parent of the entity",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVSpherePrivilegesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	filter := d.Get("filter").(string)
+
+	log.Printf("[DEBUG] Reading vsphere privileges matching '%s'", filter)
+
+	privileges, err := administrationroles.PrivilegeList(client)
+	if err != nil {
+		return err
+	}
+
+	var ids []string
+	var result []interface{}
+	for _, privilege := range privileges {
+		if filter != "" && !administrationroles.MatchPrivilege(privilege, filter) {
+			continue
+		}
+
+		ids = append(ids, privilege.PrivId)
+		result = append(result, map[string]interface{}{
+			"id":        privilege.PrivId,
+			"name":      privilege.Name,
+			"group":     privilege.PrivGroupName,
+			"label":     privilege.Label,
+			"summary":   privilege.Summary,
+			"on_parent": privilege.OnParent,
+		})
+	}
+
+	d.SetId(vspherePrivilegesID(filter))
+	_ = d.Set("ids", structure.SliceStringsToInterfaces(ids))
+	return d.Set("privileges", result)
+}
+
+func vspherePrivilegesID(filter string) string {
+	if filter == "" {
+		return "tf-privileges"
+	}
+
+	return "tf-privileges-" + filter
+}
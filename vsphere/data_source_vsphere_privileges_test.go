@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVSpherePrivileges_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSpherePrivilegesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.vsphere_privileges.alarm", "ids.*", "Alarm.Acknowledge"),
+					resource.TestCheckResourceAttr("data.vsphere_privileges.alarm", "privileges.0.group", "Alarm"),
+					resource.TestCheckResourceAttrSet("data.vsphere_privileges.alarm", "privileges.0.label"),
+					resource.TestCheckTypeSetElemAttr("data.vsphere_privileges.vm_config", "ids.*", "VirtualMachine.Config.AddNewDisk"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSpherePrivilegesConfig() string {
+	return `
+	data "vsphere_privileges" "alarm" {
+		filter = "Alarm"
+	}
+
+	data "vsphere_privileges" "vm_config" {
+		filter = "VirtualMachine.Config.*"
+	}
+	`
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceVSphereSSOPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVSphereSSOPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"password_policy": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Password policy of the sso system domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the password policy",
+						},
+						"password_lifetime_days": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum number of days a password can be used before it must be changed.  0 means passwords never expire",
+						},
+						"prohibited_previous_passwords_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of previous passwords that can't be reused",
+						},
+						"min_length": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum length of a password",
+						},
+						"max_length": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum length of a password",
+						},
+						"min_alphabetic_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum number of alphabetic characters in a password",
+						},
+						"min_uppercase_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum number of uppercase characters in a password",
+						},
+						"min_lowercase_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum number of lowercase characters in a password",
+						},
+						"min_numeric_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum number of numeric characters in a password",
+						},
+						"min_special_char_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Minimum number of special characters in a password",
+						},
+						"max_identical_adjacent_characters": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum number of identical adjacent characters in a password",
+						},
+					},
+				},
+			},
+			"lockout_policy": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Lockout policy of the sso system domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the lockout policy",
+						},
+						"max_failed_attempts": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of failed logins within failed_attempt_interval before a user is locked",
+						},
+						"failed_attempt_interval": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Time in seconds in which failed logins are counted",
+						},
+						"auto_unlock_interval": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Time in seconds after which a locked user is unlocked.  0 means users must be unlocked by an administrator",
+						},
+					},
+				},
+			},
+			"token_policy": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Token policy of the sso system domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_bearer_token_lifetime": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum lifetime in seconds of bearer tokens",
+						},
+						"max_hok_token_lifetime": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum lifetime in seconds of holder-of-key tokens",
+						},
+						"clock_tolerance": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Time difference in seconds allowed between clients and the sso server",
+						},
+						"delegation_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum number of times a token can be delegated",
+						},
+						"renew_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum number of times a token can be renewed",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVSphereSSOPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	if err := vsphereSSOPoliciesRead(d, meta.(*Client).ssoClient, true); err != nil {
+		return err
+	}
+
+	d.SetId(vsphereSSOPoliciesID)
+	return nil
+}
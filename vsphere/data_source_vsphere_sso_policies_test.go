@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVSphereSSOPolicies_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccSkipIfEsxi(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereSSOPoliciesConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vsphere_sso_policies.policies", "password_policy.0.min_length"),
+					resource.TestCheckResourceAttrSet("data.vsphere_sso_policies.policies", "lockout_policy.0.max_failed_attempts"),
+					resource.TestCheckResourceAttrSet("data.vsphere_sso_policies.policies", "token_policy.0.max_bearer_token_lifetime"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereSSOPoliciesConfig() string {
+	return `
+	data "vsphere_sso_policies" "policies" {}
+	`
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+)
+
+func dataSourceVSphereVcenterAdvancedSettings() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVSphereVcenterAdvancedSettingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"keys": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Keys of the vcenter advanced settings to read.  A key ending in '.' reads every setting under it",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"settings": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of vcenter advanced setting keys to their values",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceVSphereVcenterAdvancedSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	optManager, err := hostconfig.GetVcenterOptionManager(meta.(*Client).vimClient)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0)
+	for _, key := range d.Get("keys").(*schema.Set).List() {
+		keys = append(keys, key.(string))
+	}
+
+	values, err := hostconfig.QueryOptionValues(optManager, keys)
+	if err != nil {
+		return fmt.Errorf("error retrieving vcenter advanced settings: %s", err)
+	}
+
+	settings := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		settings[key] = hostconfig.OptionValueString(value)
+	}
+
+	d.SetId(vsphereVcenterAdvancedSettingsID)
+	d.Set("settings", settings)
+	return nil
+}
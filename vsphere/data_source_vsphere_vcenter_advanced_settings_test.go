@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVSphereVcenterAdvancedSettings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereVcenterAdvancedSettingsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.vsphere_vcenter_advanced_settings.settings",
+						"settings.event.maxAge",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereVcenterAdvancedSettingsConfig() string {
+	return `
+	data "vsphere_vcenter_advanced_settings" "settings" {
+		keys = ["event.maxAge", "task."]
+	}
+	`
+}
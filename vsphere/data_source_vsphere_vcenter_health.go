@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+)
+
+const (
+	vsphereVcenterHealthID = "tf-vcenter-health"
+
+	healthPath = "/appliance/health"
+
+	// vcenterHealthGreen is the health level reported when a component is
+	// healthy.  Other levels are gray, yellow, orange and red
+	vcenterHealthGreen = "green"
+
+	// vcenterHealthGray is the health level reported when the health of a
+	// component is unknown.  It is also used for components the vcenter
+	// version does not expose
+	vcenterHealthGray = "gray"
+)
+
+// vcenterHealthComponents maps the attribute name of each health component to
+// its endpoint under /appliance/health
+var vcenterHealthComponents = map[string]string{
+	"system":            "system",
+	"load":              "load",
+	"memory":            "mem",
+	"storage":           "storage",
+	"swap":              "swap",
+	"database":          "database",
+	"database_storage":  "database-storage",
+	"software_packages": "softwarepackages",
+	"applmgmt":          "applmgmt",
+}
+
+func dataSourceVSphereVcenterHealth() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"healthy": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True when every health component is green",
+		},
+		"degraded_components": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Names of the health components that are not green",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"last_check": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time of the last health check",
+		},
+	}
+
+	for attr, endpoint := range vcenterHealthComponents {
+		s[attr] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Health level of the '%s' component", endpoint),
+		}
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceVSphereVcenterHealthRead,
+		Schema: s,
+	}
+}
+
+func dataSourceVSphereVcenterHealthRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).restClient
+	degraded := make([]string, 0)
+
+	for attr, endpoint := range vcenterHealthComponents {
+		level, err := viapi.RestRequest[string](client, http.MethodGet, healthPath+"/"+endpoint, nil)
+		if err != nil {
+			if !strings.Contains(err.Error(), "404 Not Found") {
+				return fmt.Errorf("error retrieving '%s' health: %s", endpoint, err)
+			}
+
+			// Older vcenter versions don't expose every component.  Report
+			// those as gray without marking the appliance degraded
+			d.Set(attr, vcenterHealthGray)
+			continue
+		}
+
+		if level != vcenterHealthGreen {
+			degraded = append(degraded, attr)
+		}
+
+		d.Set(attr, level)
+	}
+
+	lastCheck, err := viapi.RestRequest[string](client, http.MethodGet, healthPath+"/system/lastcheck", nil)
+	if err != nil {
+		return fmt.Errorf("error retrieving last health check: %s", err)
+	}
+
+	sort.Strings(degraded)
+
+	d.SetId(vsphereVcenterHealthID)
+	d.Set("last_check", lastCheck)
+	d.Set("healthy", len(degraded) == 0)
+	d.Set("degraded_components", degraded)
+	return nil
+}
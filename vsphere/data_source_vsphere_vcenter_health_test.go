@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVSphereVcenterHealth_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereVcenterHealthConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.vsphere_vcenter_health.health",
+						"id",
+						vsphereVcenterHealthID,
+					),
+					resource.TestMatchResourceAttr(
+						"data.vsphere_vcenter_health.health",
+						"system",
+						regexp.MustCompile("^(green|gray|yellow|orange|red)$"),
+					),
+					resource.TestMatchResourceAttr(
+						"data.vsphere_vcenter_health.health",
+						"database",
+						regexp.MustCompile("^(green|gray|yellow|orange|red)$"),
+					),
+					resource.TestCheckResourceAttrSet(
+						"data.vsphere_vcenter_health.health",
+						"last_check",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereVcenterHealthConfig() string {
+	return `data "vsphere_vcenter_health" "health" {}`
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/vcenterservicestate"
+)
+
+func dataSourceVSphereVcenterServiceState() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVSphereVcenterServiceStateRead,
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The service state object",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key for service",
+						},
+						"startup_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Startup type of the service",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the service",
+						},
+						"health": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health of the service.  Only reported while the service is started",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVSphereVcenterServiceStateRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] entering data_source_vsphere_vcenter_service_state read function")
+
+	services, err := vcenterservicestate.GetServices(meta.(*Client).restClient)
+	if err != nil {
+		return err
+	}
+
+	// Sort keys so the list is stable between reads
+	keys := make([]string, 0, len(services))
+	for key := range services {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	srvList := make([]interface{}, 0, len(keys))
+
+	for _, key := range keys {
+		info := services[key]
+		health, _ := info["health"].(string)
+
+		srvList = append(srvList, map[string]interface{}{
+			"key":          key,
+			"startup_type": info["startup_type"],
+			"state":        info["state"],
+			"health":       health,
+		})
+	}
+
+	d.SetId(vsphereVcenterServiceStateID)
+	d.Set("service", srvList)
+
+	return nil
+}
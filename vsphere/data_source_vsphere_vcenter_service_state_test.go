@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceVSphereVcenterServiceState_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereVcenterServiceStateConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.vsphere_vcenter_service_state.srv",
+						"id",
+						vsphereVcenterServiceStateID,
+					),
+					resource.TestMatchResourceAttr(
+						"data.vsphere_vcenter_service_state.srv",
+						"service.#",
+						regexp.MustCompile("[1-9][0-9]*"),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereVcenterServiceStateConfig() string {
+	return `data "vsphere_vcenter_service_state" "srv" {}`
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package administrationroles
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// SystemPrivilegeGroup is the group of the privileges every role has, which
+// are not returned for roles and not matched by patterns
+const SystemPrivilegeGroup = "System"
+
+// Privilege is a privilege with its description
+type Privilege struct {
+	types.AuthorizationPrivilege
+	Label   string
+	Summary string
+}
+
+// PrivilegeList returns all privileges defined on the server with their
+// descriptions, sorted by id
+func PrivilegeList(client *govmomi.Client) ([]Privilege, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	if client.ServiceContent.AuthorizationManager == nil {
+		return nil, fmt.Errorf("authorization manager is not available")
+	}
+
+	var authManager mo.AuthorizationManager
+	pc := property.DefaultCollector(client.Client)
+	if err := pc.RetrieveOne(ctx, *client.ServiceContent.AuthorizationManager, []string{"privilegeList", "description"}, &authManager); err != nil {
+		return nil, fmt.Errorf("error while fetching the privilege list %s", err)
+	}
+
+	descriptions := make(map[string]types.BaseElementDescription)
+	for _, description := range authManager.Description.Privilege {
+		descriptions[description.GetElementDescription().Key] = description
+	}
+
+	privileges := make([]Privilege, 0, len(authManager.PrivilegeList))
+	for _, privilege := range authManager.PrivilegeList {
+		p := Privilege{AuthorizationPrivilege: privilege}
+
+		if description, ok := descriptions[privilege.PrivId]; ok {
+			p.Label = description.GetElementDescription().Label
+			p.Summary = description.GetElementDescription().Summary
+		}
+
+		privileges = append(privileges, p)
+	}
+
+	sort.Slice(privileges, func(i, j int) bool {
+		return privileges[i].PrivId < privileges[j].PrivId
+	})
+
+	return privileges, nil
+}
+
+// IsPrivilegePattern returns whether the privilege is a glob pattern such as
+// 'VirtualMachine.*' rather than a privilege id
+func IsPrivilegePattern(privilege string) bool {
+	return strings.ContainsAny(privilege, "*?[")
+}
+
+// MatchPrivilege returns whether the privilege id matches the filter, which is
+// either a glob pattern or a group prefix such as 'VirtualMachine.Config'
+func MatchPrivilege(privilege Privilege, filter string) bool {
+	if IsPrivilegePattern(filter) {
+		matched, _ := path.Match(filter, privilege.PrivId)
+		return matched
+	}
+
+	return privilege.PrivId == filter ||
+		privilege.PrivGroupName == filter ||
+		strings.HasPrefix(privilege.PrivId, filter+".")
+}
+
+// ExpandPrivileges validates the privileges against the privileges of the
+// server and expands glob patterns into the privileges they match.  Patterns
+// do not match system privileges.  The result is sorted and without duplicates
+func ExpandPrivileges(privileges []Privilege, ids []string) ([]string, error) {
+	known := make(map[string]bool, len(privileges))
+	for _, privilege := range privileges {
+		known[privilege.PrivId] = true
+	}
+
+	expanded := make(map[string]bool)
+	for _, id := range ids {
+		if !IsPrivilegePattern(id) {
+			if !known[id] {
+				return nil, fmt.Errorf("privilege %s does not exist", id)
+			}
+
+			expanded[id] = true
+			continue
+		}
+
+		if _, err := path.Match(id, ""); err != nil {
+			return nil, fmt.Errorf("invalid privilege pattern %s: %s", id, err)
+		}
+
+		matched := false
+		for _, privilege := range privileges {
+			if strings.Split(privilege.PrivId, ".")[0] == SystemPrivilegeGroup || !MatchPrivilege(privilege, id) {
+				continue
+			}
+
+			expanded[privilege.PrivId] = true
+			matched = true
+		}
+
+		if !matched {
+			return nil, fmt.Errorf("privilege pattern %s does not match any privilege", id)
+		}
+	}
+
+	result := make([]string, 0, len(expanded))
+	for id := range expanded {
+		result = append(result, id)
+	}
+	sort.Strings(result)
+
+	return result, nil
+}
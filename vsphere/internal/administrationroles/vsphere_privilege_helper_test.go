@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package administrationroles
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func testPrivileges(ids ...string) []Privilege {
+	privileges := make([]Privilege, 0, len(ids))
+	for _, id := range ids {
+		privileges = append(privileges, Privilege{AuthorizationPrivilege: types.AuthorizationPrivilege{PrivId: id}})
+	}
+
+	return privileges
+}
+
+func TestExpandPrivileges(t *testing.T) {
+	privileges := testPrivileges(
+		"Alarm.Acknowledge",
+		"Alarm.Create",
+		"System.Read",
+		"System.View",
+		"VirtualMachine.Config.AddNewDisk",
+		"VirtualMachine.Config.CPUCount",
+		"VirtualMachine.Interact.PowerOn",
+	)
+
+	cases := []struct {
+		name     string
+		ids      []string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "ids",
+			ids:      []string{"Alarm.Create", "Alarm.Acknowledge"},
+			expected: []string{"Alarm.Acknowledge", "Alarm.Create"},
+		},
+		{
+			name:     "nested pattern",
+			ids:      []string{"VirtualMachine.*"},
+			expected: []string{"VirtualMachine.Config.AddNewDisk", "VirtualMachine.Config.CPUCount", "VirtualMachine.Interact.PowerOn"},
+		},
+		{
+			name:     "pattern and duplicate id",
+			ids:      []string{"VirtualMachine.Config.*", "VirtualMachine.Config.CPUCount"},
+			expected: []string{"VirtualMachine.Config.AddNewDisk", "VirtualMachine.Config.CPUCount"},
+		},
+		{
+			name:     "patterns skip system privileges",
+			ids:      []string{"*"},
+			expected: []string{"Alarm.Acknowledge", "Alarm.Create", "VirtualMachine.Config.AddNewDisk", "VirtualMachine.Config.CPUCount", "VirtualMachine.Interact.PowerOn"},
+		},
+		{
+			name:     "explicit system privilege",
+			ids:      []string{"System.Read"},
+			expected: []string{"System.Read"},
+		},
+		{
+			name: "unknown id",
+			ids:  []string{"Alarm.Delete"},
+			err:  true,
+		},
+		{
+			name: "pattern without match",
+			ids:  []string{"Datastore.*"},
+			err:  true,
+		},
+		{
+			name: "invalid pattern",
+			ids:  []string{"Alarm.[*"},
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			expanded, err := ExpandPrivileges(privileges, tc.ids)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected error, got %v", expanded)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("bad: %s", err)
+			}
+
+			if !reflect.DeepEqual(expanded, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, expanded)
+			}
+		})
+	}
+}
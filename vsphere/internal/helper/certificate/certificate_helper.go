@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package certificate
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Parse decodes the first PEM encoded certificate in the given string
+func Parse(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("could not find a PEM encoded certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate: %s", err)
+	}
+
+	return cert, nil
+}
+
+// Thumbprint returns the SHA-1 thumbprint of the certificate in the colon
+// separated format vSphere uses to pin host and vcenter certificates
+func Thumbprint(cert *x509.Certificate) string {
+	sum := sha1.Sum(cert.Raw)
+	parts := make([]string, 0, len(sum))
+
+	for _, b := range sum {
+		parts = append(parts, fmt.Sprintf("%02X", b))
+	}
+
+	return strings.Join(parts, ":")
+}
+
+// Equal compares two PEM encoded certificates ignoring any differences in
+// whitespace or line endings of the encoding
+func Equal(a, b string) bool {
+	certA, err := Parse(a)
+	if err != nil {
+		return false
+	}
+
+	certB, err := Parse(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(certA.Raw, certB.Raw)
+}
+
+// Contains checks if the PEM encoded certificate is in the given list
+func Contains(certs []string, certPEM string) bool {
+	for _, c := range certs {
+		if Equal(c, certPEM) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Remove returns the given list without the PEM encoded certificate
+func Remove(certs []string, certPEM string) []string {
+	result := make([]string, 0, len(certs))
+
+	for _, c := range certs {
+		if !Equal(c, certPEM) {
+			result = append(result, c)
+		}
+	}
+
+	return result
+}
+
+// ValidatePEM is a schema validation function that makes sure the value is a
+// PEM encoded certificate
+func ValidatePEM() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(string)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+		}
+
+		if _, err := Parse(v); err != nil {
+			return nil, []error{fmt.Errorf("%s: %s", k, err)}
+		}
+
+		return nil, nil
+	}
+}
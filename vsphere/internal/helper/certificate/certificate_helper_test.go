@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testCertificatePEM(t *testing.T, cn string) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestEqual(t *testing.T) {
+	a := testCertificatePEM(t, "a.example.com")
+	b := testCertificatePEM(t, "b.example.com")
+
+	if !Equal(a, strings.ReplaceAll(a, "\n", "\r\n")) {
+		t.Fatal("expected certificates with different line endings to be equal")
+	}
+
+	if Equal(a, b) {
+		t.Fatal("expected different certificates to not be equal")
+	}
+
+	if Equal(a, "not a certificate") {
+		t.Fatal("expected invalid certificate to not be equal")
+	}
+}
+
+func TestContainsAndRemove(t *testing.T) {
+	a := testCertificatePEM(t, "a.example.com")
+	b := testCertificatePEM(t, "b.example.com")
+	certs := []string{a, b}
+
+	if !Contains(certs, b) {
+		t.Fatal("expected list to contain certificate")
+	}
+
+	certs = Remove(certs, b)
+	if len(certs) != 1 || Contains(certs, b) {
+		t.Fatalf("expected certificate to be removed, got %d certificates", len(certs))
+	}
+}
+
+func TestValidatePEM(t *testing.T) {
+	if _, errs := ValidatePEM()(testCertificatePEM(t, "a.example.com"), "ca_certificate"); len(errs) > 0 {
+		t.Fatalf("bad: %v", errs)
+	}
+
+	if _, errs := ValidatePEM()("not a certificate", "ca_certificate"); len(errs) == 0 {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestThumbprint(t *testing.T) {
+	cert, err := Parse(testCertificatePEM(t, "a.example.com"))
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	thumbprint := Thumbprint(cert)
+	parts := strings.Split(thumbprint, ":")
+
+	if len(parts) != 20 {
+		t.Fatalf("expected 20 bytes in thumbprint, got %d: %s", len(parts), thumbprint)
+	}
+
+	if thumbprint != strings.ToUpper(thumbprint) {
+		t.Fatalf("expected upper case thumbprint, got %s", thumbprint)
+	}
+}
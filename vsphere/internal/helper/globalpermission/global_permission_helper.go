@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalpermission
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"golang.org/x/net/html"
+)
+
+// Global permissions are not part of the vsphere api and are only exposed
+// through the managed object browser of the vcenter inventory service, which
+// uses basic authentication and a nonce per method invocation
+const (
+	mobPath      = "/invsvc/mob3/"
+	mobMoid      = "authorizationService"
+	nonceField   = "vmware-session-nonce"
+	resultHeader = "Method Invocation Result"
+)
+
+// Client invokes the authorization service of the vcenter inventory service
+type Client struct {
+	soap     *soap.Client
+	userinfo *url.Userinfo
+}
+
+// Permission is a global permission of a user or group
+type Permission struct {
+	Principal string
+	Group     bool
+	RoleIDs   []int64
+	Propagate bool
+}
+
+// NewClient returns a client for global permissions of the vcenter the vim
+// client is connected to.  The connection settings of the vim client are
+// reused, but the inventory service needs the credentials of the user
+func NewClient(c *vim25.Client, username, password string) *Client {
+	return &Client{
+		soap:     c.NewServiceClient(mobPath, ""),
+		userinfo: url.UserPassword(username, password),
+	}
+}
+
+// CheckClient makes sure the global permission client was created, which only
+// happens when connected to a vcenter
+func CheckClient(client *Client) error {
+	if client == nil {
+		return fmt.Errorf("global permissions are only available when connected to vcenter")
+	}
+
+	return nil
+}
+
+// List returns all global permissions
+func (c *Client) List() ([]Permission, error) {
+	body, err := c.invoke("GetGlobalAccessControlList", url.Values{})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving global permissions: %s", err)
+	}
+
+	return parseAccessControlList(body)
+}
+
+// Get returns the global permission of the principal or nil when it has none.
+// Principals are compared without case like vcenter does
+func (c *Client) Get(principal string, group bool) (*Permission, error) {
+	permissions, err := c.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range permissions {
+		if permission.Group == group && strings.EqualFold(permission.Principal, principal) {
+			p := permission
+			return &p, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Set gives the principal the role globally, replacing any global permission
+// it already has
+func (c *Client) Set(principal string, group bool, roleID int64, propagate bool) error {
+	var name bytes.Buffer
+	if err := xml.EscapeText(&name, []byte(principal)); err != nil {
+		return err
+	}
+
+	permissions := fmt.Sprintf(
+		"<permissions><principal><name>%s</name><group>%t</group></principal><roles>%d</roles><propagate>%t</propagate></permissions>",
+		name.String(),
+		group,
+		roleID,
+		propagate,
+	)
+
+	if _, err := c.invoke("AddGlobalAccessControlList", url.Values{"permissions": {permissions}}); err != nil {
+		return fmt.Errorf("error setting global permission of '%s': %s", principal, err)
+	}
+
+	return nil
+}
+
+// Remove removes the global permission of the principal
+func (c *Client) Remove(principal string, group bool) error {
+	var name bytes.Buffer
+	if err := xml.EscapeText(&name, []byte(principal)); err != nil {
+		return err
+	}
+
+	principals := fmt.Sprintf("<principals><name>%s</name><group>%t</group></principals>", name.String(), group)
+
+	if _, err := c.invoke("RemoveGlobalAccess", url.Values{"principals": {principals}}); err != nil {
+		return fmt.Errorf("error removing global permission of '%s': %s", principal, err)
+	}
+
+	return nil
+}
+
+// invoke calls the method of the authorization service and returns the result
+// page.  The method page is loaded first for the nonce the invocation needs
+func (c *Client) invoke(method string, params url.Values) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	defer c.logout(ctx)
+
+	u := c.soap.URL()
+	u.RawQuery = url.Values{
+		"moid":   {mobMoid},
+		"method": {"AuthorizationService." + method},
+	}.Encode()
+
+	page, err := c.do(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := parseNonce(page)
+	if err != nil {
+		return nil, err
+	}
+
+	params.Set(nonceField, nonce)
+
+	result, err := c.do(ctx, http.MethodPost, u, strings.NewReader(params.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Contains(result, []byte(resultHeader)) {
+		return nil, fmt.Errorf("method %s failed: %s", method, pageText(result))
+	}
+
+	return result, nil
+}
+
+func (c *Client) do(ctx context.Context, method string, u *url.URL, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	password, _ := c.userinfo.Password()
+	req.SetBasicAuth(c.userinfo.Username(), password)
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	var page []byte
+	err = c.soap.Do(ctx, req, func(res *http.Response) error {
+		if res.StatusCode != http.StatusOK {
+			return fmt.Errorf("unexpected status from inventory service: %s", res.Status)
+		}
+
+		var readErr error
+		page, readErr = io.ReadAll(res.Body)
+		return readErr
+	})
+
+	return page, err
+}
+
+func (c *Client) logout(ctx context.Context) {
+	u := c.soap.URL()
+	u.Path += "logout"
+
+	_, _ = c.do(ctx, http.MethodGet, u, nil)
+}
+
+// parseNonce returns the nonce of the method invocation form
+func parseNonce(page []byte) (string, error) {
+	z := html.NewTokenizer(bytes.NewReader(page))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			if z.Err() == io.EOF {
+				return "", fmt.Errorf("nonce not found in inventory service response")
+			}
+			return "", z.Err()
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := z.Token()
+			if t.Data != "input" {
+				continue
+			}
+
+			var name, value string
+			for _, attr := range t.Attr {
+				switch attr.Key {
+				case "name":
+					name = attr.Val
+				case "value":
+					value = attr.Val
+				}
+			}
+
+			if name == nonceField {
+				return value, nil
+			}
+		}
+	}
+}
+
+// pageText returns the text of a page for error messages
+func pageText(page []byte) string {
+	return strings.Join(pageTexts(page), " ")
+}
+
+// pageTexts returns the non-empty text nodes of a page in order
+func pageTexts(page []byte) []string {
+	var texts []string
+	z := html.NewTokenizer(bytes.NewReader(page))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return texts
+		case html.TextToken:
+			if text := strings.TrimSpace(string(z.Text())); text != "" {
+				texts = append(texts, text)
+			}
+		}
+	}
+}
+
+// parseAccessControlList reads the permissions from the result page of
+// GetGlobalAccessControlList.  The page renders each field as its name and
+// type followed by its value, with the values of arrays listed after the type
+func parseAccessControlList(page []byte) ([]Permission, error) {
+	texts := pageTexts(page)
+
+	start := -1
+	for i, text := range texts {
+		if strings.HasPrefix(text, resultHeader) {
+			start = i
+			break
+		}
+	}
+
+	if start == -1 {
+		return nil, fmt.Errorf("unexpected inventory service response: %s", strings.Join(texts, " "))
+	}
+
+	var permissions []Permission
+	var current *Permission
+
+	for i := start; i+2 < len(texts); i++ {
+		field, kind, value := texts[i], texts[i+1], texts[i+2]
+
+		switch {
+		case field == "name" && kind == "string":
+			permissions = append(permissions, Permission{Principal: value})
+			current = &permissions[len(permissions)-1]
+			i += 2
+		case current == nil:
+			continue
+		case field == "group" && kind == "boolean":
+			current.Group = value == "true"
+			i += 2
+		case field == "propagate" && kind == "boolean":
+			current.Propagate = value == "true"
+			i += 2
+		case field == "roles" && strings.HasPrefix(kind, "long"):
+			for i += 2; i < len(texts); i++ {
+				id, err := strconv.ParseInt(texts[i], 10, 64)
+				if err != nil {
+					i--
+					break
+				}
+				current.RoleIDs = append(current.RoleIDs, id)
+			}
+		}
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalpermission
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testMethodPage = `<html><body>
+<form method="post">
+<input name="vmware-session-nonce" type="hidden" value="52d1e4b6-24a4-6a80-7f2b-0f3c1c4e8a1d">
+<textarea name="permissions"></textarea>
+</form>
+</body></html>`
+
+const testAccessControlListPage = `<html><body>
+<h1>Method Invocation Result: AccessControlList[]</h1>
+<table>
+<tr><td class="c2">principal</td><td class="c1">Principal</td><td><table>
+<tr><td class="c2">name</td><td class="c1">string</td><td>VSPHERE.LOCAL\Administrator</td></tr>
+<tr><td class="c2">group</td><td class="c1">boolean</td><td>false</td></tr>
+</table></td></tr>
+<tr><td class="c2">roles</td><td class="c1">long[]</td><td><ul><li>-1</li></ul></td></tr>
+<tr><td class="c2">propagate</td><td class="c1">boolean</td><td>true</td></tr>
+<tr><td class="c2">version</td><td class="c1">long</td><td>1</td></tr>
+</table>
+<table>
+<tr><td class="c2">principal</td><td class="c1">Principal</td><td><table>
+<tr><td class="c2">name</td><td class="c1">string</td><td>EXAMPLE\vSphere Auditors</td></tr>
+<tr><td class="c2">group</td><td class="c1">boolean</td><td>true</td></tr>
+</table></td></tr>
+<tr><td class="c2">roles</td><td class="c1">long[]</td><td><ul><li>2</li><li>1001</li></ul></td></tr>
+<tr><td class="c2">propagate</td><td class="c1">boolean</td><td>false</td></tr>
+<tr><td class="c2">version</td><td class="c1">long</td><td>3</td></tr>
+</table>
+</body></html>`
+
+func TestParseNonce(t *testing.T) {
+	nonce, err := parseNonce([]byte(testMethodPage))
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	if nonce != "52d1e4b6-24a4-6a80-7f2b-0f3c1c4e8a1d" {
+		t.Fatalf("expected nonce from form, got '%s'", nonce)
+	}
+
+	if _, err = parseNonce([]byte("<html></html>")); err == nil {
+		t.Fatalf("expected error for page without nonce")
+	}
+}
+
+func TestParseAccessControlList(t *testing.T) {
+	permissions, err := parseAccessControlList([]byte(testAccessControlListPage))
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	expected := []Permission{
+		{
+			Principal: `VSPHERE.LOCAL\Administrator`,
+			RoleIDs:   []int64{-1},
+			Propagate: true,
+		},
+		{
+			Principal: `EXAMPLE\vSphere Auditors`,
+			Group:     true,
+			RoleIDs:   []int64{2, 1001},
+		},
+	}
+
+	if !reflect.DeepEqual(permissions, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, permissions)
+	}
+
+	if _, err = parseAccessControlList([]byte("<html><body>Fault</body></html>")); err == nil {
+		t.Fatalf("expected error for page without result")
+	}
+}
@@ -0,0 +1,263 @@
+package hostconfig
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func GetAccountManager(client *govmomi.Client, host *object.HostSystem) (*object.HostAccountManager, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	accountManager, err := host.ConfigManager().AccountManager(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving account manager for host '%s': %s", host.Name(), err)
+	}
+
+	return accountManager, nil
+}
+
+// GetHostLocalUser returns the local user of the host with the given name or
+// nil when there is no such user
+func GetHostLocalUser(client *govmomi.Client, host *object.HostSystem, username string) (*types.UserSearchResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var hostProps mo.HostSystem
+	if err := host.Properties(ctx, host.Reference(), []string{"configManager.userDirectory"}, &hostProps); err != nil {
+		return nil, fmt.Errorf("error retrieving user directory for host '%s': %s", host.Name(), err)
+	}
+
+	if hostProps.ConfigManager.UserDirectory == nil {
+		return nil, fmt.Errorf("host '%s' has no user directory", host.Name())
+	}
+
+	resp, err := methods.RetrieveUserGroups(ctx, client, &types.RetrieveUserGroups{
+		This:       *hostProps.ConfigManager.UserDirectory,
+		SearchStr:  username,
+		ExactMatch: true,
+		FindUsers:  true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error searching for user '%s' on host '%s': %s", username, host.Name(), err)
+	}
+
+	for _, result := range resp.Returnval {
+		if user, ok := result.(*types.UserSearchResult); ok && !user.Group && user.Principal == username {
+			return user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// CreateHostLocalUser creates a local user on the host
+func CreateHostLocalUser(client *govmomi.Client, host *object.HostSystem, spec *types.HostAccountSpec) error {
+	accountManager, err := GetAccountManager(client, host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	if err = accountManager.Create(ctx, spec); err != nil {
+		return fmt.Errorf("error creating user '%s' on host '%s': %s", spec.Id, host.Name(), err)
+	}
+
+	return nil
+}
+
+// UpdateHostLocalUser updates the description of a local user of the host and
+// its password when set in the spec
+func UpdateHostLocalUser(client *govmomi.Client, host *object.HostSystem, spec *types.HostAccountSpec) error {
+	accountManager, err := GetAccountManager(client, host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	if err = accountManager.Update(ctx, spec); err != nil {
+		return fmt.Errorf("error updating user '%s' on host '%s': %s", spec.Id, host.Name(), err)
+	}
+
+	return nil
+}
+
+// RemoveHostLocalUser removes a local user from the host
+func RemoveHostLocalUser(client *govmomi.Client, host *object.HostSystem, username string) error {
+	accountManager, err := GetAccountManager(client, host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	if err = accountManager.Remove(ctx, username); err != nil {
+		return fmt.Errorf("error removing user '%s' from host '%s': %s", username, host.Name(), err)
+	}
+
+	return nil
+}
+
+// NewHostSession returns a client connected to the host itself, which is
+// needed for the authorization manager of the host as vcenter only exposes its
+// own.  When the provider is connected to the host directly its client is
+// returned, otherwise a session is opened with the given credentials trusting
+// the certificate thumbprint vcenter knows for the host.  The returned function
+// ends the session
+func NewHostSession(client *govmomi.Client, host *object.HostSystem, username, password string) (*govmomi.Client, func(), error) {
+	if !client.IsVC() {
+		return client, func() {}, nil
+	}
+
+	if username == "" || password == "" {
+		return nil, nil, fmt.Errorf("credentials are required to manage permissions on host '%s' through vcenter", host.Name())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var hostProps mo.HostSystem
+	if err := host.Properties(ctx, host.Reference(), []string{"summary.config"}, &hostProps); err != nil {
+		return nil, nil, fmt.Errorf("error retrieving connection info for host '%s': %s", host.Name(), err)
+	}
+
+	config := hostProps.Summary.Config
+	u := &url.URL{
+		Scheme: "https",
+		Host:   fmt.Sprintf("%s:%d", config.Name, config.Port),
+		Path:   vim25.Path,
+	}
+
+	soapClient := soap.NewClient(u, false)
+	if config.SslThumbprint != "" {
+		soapClient.SetThumbprint(u.Host, config.SslThumbprint)
+	}
+
+	vimClient, err := vim25.NewClient(ctx, soapClient)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error connecting to host '%s': %s", host.Name(), err)
+	}
+
+	hostClient := &govmomi.Client{
+		Client:         vimClient,
+		SessionManager: session.NewManager(vimClient),
+	}
+
+	if err = hostClient.Login(ctx, url.UserPassword(username, password)); err != nil {
+		return nil, nil, fmt.Errorf("error logging in to host '%s': %s", host.Name(), err)
+	}
+
+	logout := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+		defer cancel()
+
+		if err := hostClient.Logout(ctx); err != nil {
+			log.Printf("[DEBUG] error logging out of host '%s': %s", host.Name(), err)
+		}
+	}
+
+	return hostClient, logout, nil
+}
+
+// GetHostPermissionRole returns the name of the role the principal has on the
+// host or an empty string when it has no permission.  The client must be
+// connected to the host itself
+func GetHostPermissionRole(hostClient *govmomi.Client, principal string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	authManager := object.NewAuthorizationManager(hostClient.Client)
+
+	permissions, err := authManager.RetrieveEntityPermissions(ctx, hostClient.ServiceContent.RootFolder, false)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving host permissions: %s", err)
+	}
+
+	for _, permission := range permissions {
+		if permission.Principal != principal || permission.Group {
+			continue
+		}
+
+		roles, err := authManager.RoleList(ctx)
+		if err != nil {
+			return "", fmt.Errorf("error retrieving host roles: %s", err)
+		}
+
+		role := roles.ById(permission.RoleId)
+		if role == nil {
+			return "", fmt.Errorf("role %d of '%s' not found on host", permission.RoleId, principal)
+		}
+
+		return role.Name, nil
+	}
+
+	return "", nil
+}
+
+// SetHostPermissionRole gives the principal the role on the host.  The client
+// must be connected to the host itself
+func SetHostPermissionRole(hostClient *govmomi.Client, principal, roleName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	authManager := object.NewAuthorizationManager(hostClient.Client)
+
+	roles, err := authManager.RoleList(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving host roles: %s", err)
+	}
+
+	role := roles.ByName(roleName)
+	if role == nil {
+		return fmt.Errorf("role '%s' not found on host", roleName)
+	}
+
+	err = authManager.SetEntityPermissions(ctx, hostClient.ServiceContent.RootFolder, []types.Permission{
+		{
+			Principal: principal,
+			RoleId:    role.RoleId,
+			Propagate: true,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error giving '%s' role '%s' on host: %s", principal, roleName, err)
+	}
+
+	return nil
+}
+
+// RemoveHostPermission removes the permission of the principal from the host
+// if it has one.  The client must be connected to the host itself
+func RemoveHostPermission(hostClient *govmomi.Client, principal string) error {
+	role, err := GetHostPermissionRole(hostClient, principal)
+	if err != nil || role == "" {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	authManager := object.NewAuthorizationManager(hostClient.Client)
+	if err = authManager.RemoveEntityPermission(ctx, hostClient.ServiceContent.RootFolder, principal, false); err != nil {
+		return fmt.Errorf("error removing permission of '%s' from host: %s", principal, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package hostconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	// EsxAdminsGroupKey is the option of the active directory group whose
+	// members get the administrator role on the host
+	EsxAdminsGroupKey = "Config.HostAgent.plugins.hostsvc.esxAdminsGroup"
+
+	hostActiveDirectoryAuthenticationType = "HostActiveDirectoryAuthentication"
+)
+
+// getHostAuthenticationManager returns the authentication manager of the host
+// which holds the active directory membership and its store
+func getHostAuthenticationManager(client *govmomi.Client, host *object.HostSystem) (*mo.HostAuthenticationManager, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var hostProps mo.HostSystem
+	if err := host.Properties(ctx, host.Reference(), []string{"configManager.authenticationManager"}, &hostProps); err != nil {
+		return nil, fmt.Errorf("error retrieving authentication manager for host '%s': %s", host.Name(), err)
+	}
+
+	if hostProps.ConfigManager.AuthenticationManager == nil {
+		return nil, fmt.Errorf("host '%s' has no authentication manager", host.Name())
+	}
+
+	var authManager mo.HostAuthenticationManager
+	pc := property.DefaultCollector(client.Client)
+	if err := pc.RetrieveOne(ctx, *hostProps.ConfigManager.AuthenticationManager, []string{"info", "supportedStore"}, &authManager); err != nil {
+		return nil, fmt.Errorf("error retrieving authentication manager for host '%s': %s", host.Name(), err)
+	}
+
+	return &authManager, nil
+}
+
+// GetHostActiveDirectoryInfo returns the domain membership of the host.  The
+// info is not enabled when the host is not joined to a domain
+func GetHostActiveDirectoryInfo(client *govmomi.Client, host *object.HostSystem) (*types.HostActiveDirectoryInfo, error) {
+	authManager, err := getHostAuthenticationManager(client, host)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, store := range authManager.Info.AuthConfig {
+		if info, ok := store.(*types.HostActiveDirectoryInfo); ok {
+			return info, nil
+		}
+	}
+
+	return &types.HostActiveDirectoryInfo{}, nil
+}
+
+// getHostActiveDirectoryAuthentication returns the active directory store of
+// the host used to join and leave domains
+func getHostActiveDirectoryAuthentication(client *govmomi.Client, host *object.HostSystem) (types.ManagedObjectReference, error) {
+	authManager, err := getHostAuthenticationManager(client, host)
+	if err != nil {
+		return types.ManagedObjectReference{}, err
+	}
+
+	for _, store := range authManager.SupportedStore {
+		if store.Type == hostActiveDirectoryAuthenticationType {
+			return store, nil
+		}
+	}
+
+	return types.ManagedObjectReference{}, fmt.Errorf("host '%s' does not support active directory authentication", host.Name())
+}
+
+// JoinHostDomain joins the host to the active directory domain with the
+// credentials of an account allowed to add computers to the domain, or through
+// the vSphere Authentication Proxy when camServer is set
+func JoinHostDomain(client *govmomi.Client, host *object.HostSystem, domain, username, password, camServer string) error {
+	adAuth, err := getHostActiveDirectoryAuthentication(client, host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var task types.ManagedObjectReference
+
+	if camServer != "" {
+		var resp *types.JoinDomainWithCAM_TaskResponse
+		resp, err = methods.JoinDomainWithCAM_Task(ctx, client, &types.JoinDomainWithCAM_Task{
+			This:       adAuth,
+			DomainName: domain,
+			CamServer:  camServer,
+		})
+		if resp != nil {
+			task = resp.Returnval
+		}
+	} else {
+		var resp *types.JoinDomain_TaskResponse
+		resp, err = methods.JoinDomain_Task(ctx, client, &types.JoinDomain_Task{
+			This:       adAuth,
+			DomainName: domain,
+			UserName:   username,
+			Password:   password,
+		})
+		if resp != nil {
+			task = resp.Returnval
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("error joining host '%s' to domain '%s': %s", host.Name(), domain, err)
+	}
+
+	if err = object.NewTask(client.Client, task).Wait(ctx); err != nil {
+		return fmt.Errorf("error waiting for host '%s' to join domain '%s': %s", host.Name(), domain, err)
+	}
+
+	return nil
+}
+
+// LeaveHostDomain removes the host from its current domain.  With force any
+// permissions of active directory users on the host are removed, otherwise
+// leaving fails when such permissions exist
+func LeaveHostDomain(client *govmomi.Client, host *object.HostSystem, force bool) error {
+	adAuth, err := getHostActiveDirectoryAuthentication(client, host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	resp, err := methods.LeaveCurrentDomain_Task(ctx, client, &types.LeaveCurrentDomain_Task{
+		This:  adAuth,
+		Force: force,
+	})
+	if err != nil {
+		return fmt.Errorf("error leaving domain for host '%s': %s", host.Name(), err)
+	}
+
+	if err = object.NewTask(client.Client, resp.Returnval).Wait(ctx); err != nil {
+		return fmt.Errorf("error waiting for host '%s' to leave domain: %s", host.Name(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package hostconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func GetCertificateManager(client *govmomi.Client, host *object.HostSystem) (*object.HostCertificateManager, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	certManager, err := host.ConfigManager().CertificateManager(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving certificate manager for host '%s': %s", host.Name(), err)
+	}
+
+	return certManager, nil
+}
+
+// GetHostCertificateInfo returns the certificate info of the host including
+// the SHA-1 thumbprint vcenter uses to connect to it
+func GetHostCertificateInfo(client *govmomi.Client, host *object.HostSystem) (*object.HostCertificateInfo, error) {
+	certManager, err := GetCertificateManager(client, host)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	info, err := certManager.CertificateInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving certificate info for host '%s': %s", host.Name(), err)
+	}
+
+	return info, nil
+}
+
+// GenerateHostCSR generates a certificate signing request on the host.  The
+// host keeps the private key until a certificate signed from the request is
+// installed.  An empty distinguished name uses the hostname as common name
+func GenerateHostCSR(client *govmomi.Client, host *object.HostSystem, distinguishedName string) (string, error) {
+	certManager, err := GetCertificateManager(client, host)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var csr string
+	if distinguishedName != "" {
+		csr, err = certManager.GenerateCertificateSigningRequestByDn(ctx, distinguishedName)
+	} else {
+		csr, err = certManager.GenerateCertificateSigningRequest(ctx, false)
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("error generating csr for host '%s': %s", host.Name(), err)
+	}
+
+	return csr, nil
+}
+
+// InstallHostCertificate installs the PEM encoded certificate as the ssl
+// certificate of the host
+func InstallHostCertificate(client *govmomi.Client, host *object.HostSystem, certPEM string) error {
+	certManager, err := GetCertificateManager(client, host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	if err = certManager.InstallServerCertificate(ctx, certPEM); err != nil {
+		return fmt.Errorf("error installing certificate on host '%s': %s", host.Name(), err)
+	}
+
+	return nil
+}
+
+// ListHostCACertificates returns the trusted CA certificates and CRLs of the
+// host
+func ListHostCACertificates(client *govmomi.Client, host *object.HostSystem) ([]string, []string, error) {
+	certManager, err := GetCertificateManager(client, host)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	caCerts, err := certManager.ListCACertificates(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing ca certificates for host '%s': %s", host.Name(), err)
+	}
+
+	caCrls, err := certManager.ListCACertificateRevocationLists(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing ca certificate revocation lists for host '%s': %s", host.Name(), err)
+	}
+
+	return caCerts, caCrls, nil
+}
+
+// ReplaceHostCACertificates replaces all trusted CA certificates and CRLs of
+// the host
+func ReplaceHostCACertificates(client *govmomi.Client, host *object.HostSystem, caCerts, caCrls []string) error {
+	certManager, err := GetCertificateManager(client, host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	if err = certManager.ReplaceCACertificatesAndCRLs(ctx, caCerts, caCrls); err != nil {
+		return fmt.Errorf("error replacing ca certificates for host '%s': %s", host.Name(), err)
+	}
+
+	return nil
+}
+
+// RefreshHostCACertificates pushes the CA certificates and CRLs trusted by
+// vcenter to the host
+func RefreshHostCACertificates(client *govmomi.Client, host *object.HostSystem) error {
+	if client.ServiceContent.CertificateManager == nil {
+		return fmt.Errorf("certificate manager is only available on vcenter")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	req := &types.CertMgrRefreshCACertificatesAndCRLs_Task{
+		This: *client.ServiceContent.CertificateManager,
+		Host: []types.ManagedObjectReference{host.Reference()},
+	}
+
+	resp, err := methods.CertMgrRefreshCACertificatesAndCRLs_Task(ctx, client, req)
+	if err != nil {
+		return fmt.Errorf("error refreshing ca certificates for host '%s': %s", host.Name(), err)
+	}
+
+	task := object.NewTask(client.Client, resp.Returnval)
+	if err = task.Wait(ctx); err != nil {
+		return fmt.Errorf("error waiting for ca certificates of host '%s' to refresh: %s", host.Name(), err)
+	}
+
+	return nil
+}
+
+// HostHasCACertificate checks if the PEM encoded certificate is in the
+// trusted CA certificates of the host
+func HostHasCACertificate(client *govmomi.Client, host *object.HostSystem, certPEM string) (bool, error) {
+	caCerts, _, err := ListHostCACertificates(client, host)
+	if err != nil {
+		return false, err
+	}
+
+	return certificate.Contains(caCerts, certPEM), nil
+}
+
+// UpdateHostCACertificate swaps the old PEM encoded certificate for the new one
+// in the trusted CA certificates of the host, leaving all other CA
+// certificates and CRLs in place.  Either can be empty to only add or remove
+func UpdateHostCACertificate(client *govmomi.Client, host *object.HostSystem, oldPEM, newPEM string) error {
+	caCerts, caCrls, err := ListHostCACertificates(client, host)
+	if err != nil {
+		return err
+	}
+
+	updatedCerts := caCerts
+	changed := false
+
+	if oldPEM != "" && !certificate.Equal(oldPEM, newPEM) && certificate.Contains(updatedCerts, oldPEM) {
+		updatedCerts = certificate.Remove(updatedCerts, oldPEM)
+		changed = true
+	}
+
+	if newPEM != "" && !certificate.Contains(updatedCerts, newPEM) {
+		updatedCerts = append(updatedCerts, newPEM)
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return ReplaceHostCACertificates(client, host, updatedCerts, caCrls)
+}
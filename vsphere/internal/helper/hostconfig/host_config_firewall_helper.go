@@ -0,0 +1,178 @@
+package hostconfig
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func GetFirewallSystem(client *govmomi.Client, host *object.HostSystem) (*object.HostFirewallSystem, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	firewallSystem, err := host.ConfigManager().FirewallSystem(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving firewall system for host '%s': %s", host.Name(), err)
+	}
+
+	return firewallSystem, nil
+}
+
+// GetHostFirewallInfo returns the default policy and all rulesets of the host
+// firewall
+func GetHostFirewallInfo(client *govmomi.Client, host *object.HostSystem) (*types.HostFirewallInfo, error) {
+	firewallSystem, err := GetFirewallSystem(client, host)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	info, err := firewallSystem.Info(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving firewall info for host '%s': %s", host.Name(), err)
+	}
+
+	if info == nil {
+		return nil, fmt.Errorf("host '%s' did not return any firewall info", host.Name())
+	}
+
+	return info, nil
+}
+
+// SetHostFirewallRulesetEnabled opens or closes the ports of the ruleset
+func SetHostFirewallRulesetEnabled(client *govmomi.Client, host *object.HostSystem, key string, enabled bool) error {
+	firewallSystem, err := GetFirewallSystem(client, host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	if enabled {
+		err = firewallSystem.EnableRuleset(ctx, key)
+	} else {
+		err = firewallSystem.DisableRuleset(ctx, key)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error setting firewall ruleset '%s' enabled to %t on host '%s': %s", key, enabled, host.Name(), err)
+	}
+
+	return nil
+}
+
+// UpdateHostFirewallRulesetAllowedHosts restricts the ruleset to the given ip
+// addresses and networks in CIDR notation.  An empty list allows all addresses
+func UpdateHostFirewallRulesetAllowedHosts(client *govmomi.Client, host *object.HostSystem, key string, allowedHosts []string) error {
+	firewallSystem, err := GetFirewallSystem(client, host)
+	if err != nil {
+		return err
+	}
+
+	ipList, err := FirewallIPListFromStrings(allowedHosts)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	req := types.UpdateRuleset{
+		This: firewallSystem.Reference(),
+		Id:   key,
+		Spec: types.HostFirewallRulesetRulesetSpec{
+			AllowedHosts: *ipList,
+		},
+	}
+
+	if _, err = methods.UpdateRuleset(ctx, client.Client, &req); err != nil {
+		return fmt.Errorf("error updating allowed hosts of firewall ruleset '%s' on host '%s': %s", key, host.Name(), err)
+	}
+
+	return nil
+}
+
+// UpdateHostFirewallDefaultPolicy sets whether traffic not matched by an
+// enabled ruleset is blocked
+func UpdateHostFirewallDefaultPolicy(client *govmomi.Client, host *object.HostSystem, incomingBlocked, outgoingBlocked bool) error {
+	firewallSystem, err := GetFirewallSystem(client, host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	req := types.UpdateDefaultPolicy{
+		This: firewallSystem.Reference(),
+		DefaultPolicy: types.HostFirewallDefaultPolicy{
+			IncomingBlocked: &incomingBlocked,
+			OutgoingBlocked: &outgoingBlocked,
+		},
+	}
+
+	if _, err = methods.UpdateDefaultPolicy(ctx, client.Client, &req); err != nil {
+		return fmt.Errorf("error updating firewall default policy on host '%s': %s", host.Name(), err)
+	}
+
+	return nil
+}
+
+// FirewallIPListFromStrings converts ip addresses and networks in CIDR notation
+// into the allowed hosts of a ruleset.  An empty list allows all addresses
+func FirewallIPListFromStrings(allowedHosts []string) (*types.HostFirewallRulesetIpList, error) {
+	ipList := &types.HostFirewallRulesetIpList{
+		AllIp: len(allowedHosts) == 0,
+	}
+
+	for _, allowed := range allowedHosts {
+		if ip, network, err := net.ParseCIDR(allowed); err == nil {
+			if !ip.Equal(network.IP) {
+				return nil, fmt.Errorf("'%s' is not a network address, use '%s'", allowed, network.String())
+			}
+
+			prefix, _ := network.Mask.Size()
+			ipList.IpNetwork = append(ipList.IpNetwork, types.HostFirewallRulesetIpNetwork{
+				Network:      network.IP.String(),
+				PrefixLength: int32(prefix),
+			})
+			continue
+		}
+
+		if net.ParseIP(allowed) == nil {
+			return nil, fmt.Errorf("'%s' is neither an ip address nor a network in CIDR notation", allowed)
+		}
+
+		ipList.IpAddress = append(ipList.IpAddress, allowed)
+	}
+
+	return ipList, nil
+}
+
+// FirewallIPListToStrings converts the allowed hosts of a ruleset into sorted
+// ip addresses and networks in CIDR notation.  Rulesets allowing all addresses
+// return an empty list
+func FirewallIPListToStrings(ipList *types.HostFirewallRulesetIpList) []string {
+	allowedHosts := make([]string, 0)
+	if ipList == nil || ipList.AllIp {
+		return allowedHosts
+	}
+
+	allowedHosts = append(allowedHosts, ipList.IpAddress...)
+	for _, network := range ipList.IpNetwork {
+		allowedHosts = append(allowedHosts, fmt.Sprintf("%s/%d", network.Network, network.PrefixLength))
+	}
+
+	sort.Strings(allowedHosts)
+	return allowedHosts
+}
@@ -0,0 +1,66 @@
+package hostconfig
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestFirewallIPListFromStrings(t *testing.T) {
+	cases := []struct {
+		name      string
+		hosts     []string
+		expected  *types.HostFirewallRulesetIpList
+		expectErr bool
+	}{
+		{name: "all", hosts: nil, expected: &types.HostFirewallRulesetIpList{AllIp: true}},
+		{
+			name:  "addresses and networks",
+			hosts: []string{"192.0.2.10", "10.0.0.0/8", "2001:db8::/32"},
+			expected: &types.HostFirewallRulesetIpList{
+				IpAddress: []string{"192.0.2.10"},
+				IpNetwork: []types.HostFirewallRulesetIpNetwork{
+					{Network: "10.0.0.0", PrefixLength: 8},
+					{Network: "2001:db8::", PrefixLength: 32},
+				},
+			},
+		},
+		{name: "host bits set", hosts: []string{"10.0.0.1/8"}, expectErr: true},
+		{name: "invalid", hosts: []string{"example.com"}, expectErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := FirewallIPListFromStrings(tc.hosts)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("bad: %s", err)
+			}
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Fatalf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFirewallIPListToStrings(t *testing.T) {
+	ipList := &types.HostFirewallRulesetIpList{
+		IpAddress: []string{"192.0.2.10"},
+		IpNetwork: []types.HostFirewallRulesetIpNetwork{{Network: "10.0.0.0", PrefixLength: 8}},
+	}
+
+	expected := []string{"10.0.0.0/8", "192.0.2.10"}
+	if actual := FirewallIPListToStrings(ipList); !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+
+	if actual := FirewallIPListToStrings(&types.HostFirewallRulesetIpList{AllIp: true}); len(actual) != 0 {
+		t.Fatalf("expected no hosts, got %#v", actual)
+	}
+}
@@ -20,3 +20,13 @@ func GetOptionManager(client *govmomi.Client, host *object.HostSystem) (*object.
 
 	return optManager, nil
 }
+
+// GetVcenterOptionManager returns the option manager of vcenter itself which
+// holds vcenter advanced settings
+func GetVcenterOptionManager(client *govmomi.Client) (*object.OptionManager, error) {
+	if client.ServiceContent.Setting == nil {
+		return nil, fmt.Errorf("could not find option manager for vcenter")
+	}
+
+	return object.NewOptionManager(client.Client, *client.ServiceContent.Setting), nil
+}
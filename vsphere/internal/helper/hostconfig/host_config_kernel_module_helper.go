@@ -0,0 +1,104 @@
+package hostconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func getKernelModuleSystem(host *object.HostSystem) (types.ManagedObjectReference, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var hostProps mo.HostSystem
+	if err := host.Properties(ctx, host.Reference(), []string{"configManager.kernelModuleSystem"}, &hostProps); err != nil {
+		return types.ManagedObjectReference{}, fmt.Errorf("error retrieving kernel module system for host '%s': %s", host.Name(), err)
+	}
+
+	if hostProps.ConfigManager.KernelModuleSystem == nil {
+		return types.ManagedObjectReference{}, fmt.Errorf("host '%s' does not support kernel module management", host.Name())
+	}
+
+	return *hostProps.ConfigManager.KernelModuleSystem, nil
+}
+
+// GetHostKernelModule returns the loaded kernel module of the host with the
+// given name or nil when it is not loaded
+func GetHostKernelModule(client *govmomi.Client, host *object.HostSystem, name string) (*types.KernelModuleInfo, error) {
+	ref, err := getKernelModuleSystem(host)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	res, err := methods.QueryModules(ctx, client.Client, &types.QueryModules{This: ref})
+	if err != nil {
+		return nil, fmt.Errorf("error querying kernel modules of host '%s': %s", host.Name(), err)
+	}
+
+	for _, module := range res.Returnval {
+		if module.Name == name {
+			m := module
+			return &m, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GetHostKernelModuleOptions returns the option string configured for the
+// kernel module, which is applied the next time the module is loaded
+func GetHostKernelModuleOptions(client *govmomi.Client, host *object.HostSystem, name string) (string, error) {
+	ref, err := getKernelModuleSystem(host)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	req := types.QueryConfiguredModuleOptionString{
+		This: ref,
+		Name: name,
+	}
+
+	res, err := methods.QueryConfiguredModuleOptionString(ctx, client.Client, &req)
+	if err != nil {
+		return "", fmt.Errorf("error querying options of kernel module '%s' on host '%s': %s", name, host.Name(), err)
+	}
+
+	return res.Returnval, nil
+}
+
+// UpdateHostKernelModuleOptions sets the option string of the kernel module.
+// The options only take effect once the module is reloaded, usually by
+// rebooting the host
+func UpdateHostKernelModuleOptions(client *govmomi.Client, host *object.HostSystem, name, options string) error {
+	ref, err := getKernelModuleSystem(host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	req := types.UpdateModuleOptionString{
+		This:    ref,
+		Name:    name,
+		Options: options,
+	}
+
+	if _, err = methods.UpdateModuleOptionString(ctx, client.Client, &req); err != nil {
+		return fmt.Errorf("error updating options of kernel module '%s' on host '%s': %s", name, host.Name(), err)
+	}
+
+	return nil
+}
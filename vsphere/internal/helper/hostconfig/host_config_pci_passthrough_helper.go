@@ -0,0 +1,96 @@
+package hostconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func getPciPassthruSystem(client *govmomi.Client, host *object.HostSystem) (types.ManagedObjectReference, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var hostProps mo.HostSystem
+	if err := host.Properties(ctx, host.Reference(), []string{"configManager.pciPassthruSystem"}, &hostProps); err != nil {
+		return types.ManagedObjectReference{}, fmt.Errorf("error retrieving pci passthrough system for host '%s': %s", host.Name(), err)
+	}
+
+	if hostProps.ConfigManager.PciPassthruSystem == nil {
+		return types.ManagedObjectReference{}, fmt.Errorf("host '%s' does not support pci passthrough", host.Name())
+	}
+
+	return *hostProps.ConfigManager.PciPassthruSystem, nil
+}
+
+// GetHostPciPassthruInfo returns the passthrough state of the pci devices of
+// the host keyed by device id.  SR-IOV capable devices are returned as
+// *types.HostSriovInfo
+func GetHostPciPassthruInfo(client *govmomi.Client, host *object.HostSystem) (map[string]types.BaseHostPciPassthruInfo, error) {
+	ref, err := getPciPassthruSystem(client, host)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var passthruSystem mo.HostPciPassthruSystem
+	pc := property.DefaultCollector(client.Client)
+	if err = pc.RetrieveOne(ctx, ref, []string{"pciPassthruInfo"}, &passthruSystem); err != nil {
+		return nil, fmt.Errorf("error retrieving pci passthrough info for host '%s': %s", host.Name(), err)
+	}
+
+	devices := make(map[string]types.BaseHostPciPassthruInfo, len(passthruSystem.PciPassthruInfo))
+	for _, info := range passthruSystem.PciPassthruInfo {
+		devices[info.GetHostPciPassthruInfo().Id] = info
+	}
+
+	return devices, nil
+}
+
+// UpdateHostPciPassthruConfig updates the passthrough configuration of the
+// given pci devices.  Most changes only take effect once the host is rebooted
+func UpdateHostPciPassthruConfig(client *govmomi.Client, host *object.HostSystem, config []types.BaseHostPciPassthruConfig) error {
+	if len(config) == 0 {
+		return nil
+	}
+
+	ref, err := getPciPassthruSystem(client, host)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	req := types.UpdatePassthruConfig{
+		This:   ref,
+		Config: config,
+	}
+
+	if _, err = methods.UpdatePassthruConfig(ctx, client.Client, &req); err != nil {
+		return fmt.Errorf("error updating pci passthrough config for host '%s': %s", host.Name(), err)
+	}
+
+	return nil
+}
+
+// PciPassthruRebootRequired returns whether the configured passthrough state of
+// the device differs from its active state until the host is rebooted
+func PciPassthruRebootRequired(info types.BaseHostPciPassthruInfo) bool {
+	if sriov, ok := info.(*types.HostSriovInfo); ok && sriov.SriovCapable {
+		if sriov.SriovEnabled != sriov.SriovActive || sriov.NumVirtualFunctionRequested != sriov.NumVirtualFunction {
+			return true
+		}
+	}
+
+	passthru := info.GetHostPciPassthruInfo()
+	return passthru.PassthruEnabled != passthru.PassthruActive
+}
@@ -0,0 +1,56 @@
+package hostconfig
+
+import (
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestPciPassthruRebootRequired(t *testing.T) {
+	cases := []struct {
+		name     string
+		info     types.BaseHostPciPassthruInfo
+		expected bool
+	}{
+		{
+			name:     "passthrough active",
+			info:     &types.HostPciPassthruInfo{PassthruEnabled: true, PassthruActive: true},
+			expected: false,
+		},
+		{
+			name:     "passthrough pending",
+			info:     &types.HostPciPassthruInfo{PassthruEnabled: true},
+			expected: true,
+		},
+		{
+			name: "sriov active",
+			info: &types.HostSriovInfo{
+				SriovCapable:                true,
+				SriovEnabled:                true,
+				SriovActive:                 true,
+				NumVirtualFunctionRequested: 4,
+				NumVirtualFunction:          4,
+			},
+			expected: false,
+		},
+		{
+			name: "sriov virtual functions pending",
+			info: &types.HostSriovInfo{
+				SriovCapable:                true,
+				SriovEnabled:                true,
+				SriovActive:                 true,
+				NumVirtualFunctionRequested: 8,
+				NumVirtualFunction:          4,
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := PciPassthruRebootRequired(tc.info); actual != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
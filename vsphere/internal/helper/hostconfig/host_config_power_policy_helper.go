@@ -0,0 +1,76 @@
+package hostconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// GetHostPowerSystem returns the current power policy of the host along with
+// the policies it supports
+func GetHostPowerSystem(client *govmomi.Client, host *object.HostSystem) (*mo.HostPowerSystem, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var hostProps mo.HostSystem
+	if err := host.Properties(ctx, host.Reference(), []string{"configManager.powerSystem"}, &hostProps); err != nil {
+		return nil, fmt.Errorf("error retrieving power system for host '%s': %s", host.Name(), err)
+	}
+
+	if hostProps.ConfigManager.PowerSystem == nil {
+		return nil, fmt.Errorf("host '%s' does not support power management", host.Name())
+	}
+
+	var powerSystem mo.HostPowerSystem
+	pc := property.DefaultCollector(client.Client)
+	if err := pc.RetrieveOne(ctx, *hostProps.ConfigManager.PowerSystem, []string{"capability", "info"}, &powerSystem); err != nil {
+		return nil, fmt.Errorf("error retrieving power policy for host '%s': %s", host.Name(), err)
+	}
+
+	return &powerSystem, nil
+}
+
+// SetHostPowerPolicy sets the power policy of the host by its short name such
+// as 'static' for high performance or 'dynamic' for balanced
+func SetHostPowerPolicy(client *govmomi.Client, host *object.HostSystem, shortName string) error {
+	powerSystem, err := GetHostPowerSystem(client, host)
+	if err != nil {
+		return err
+	}
+
+	if powerSystem.Info.CurrentPolicy.ShortName == shortName {
+		return nil
+	}
+
+	available := make([]string, 0, len(powerSystem.Capability.AvailablePolicy))
+	for _, policy := range powerSystem.Capability.AvailablePolicy {
+		if policy.ShortName != shortName {
+			available = append(available, policy.ShortName)
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+		defer cancel()
+
+		req := types.ConfigurePowerPolicy{
+			This: powerSystem.Self,
+			Key:  policy.Key,
+		}
+
+		if _, err = methods.ConfigurePowerPolicy(ctx, client.Client, &req); err != nil {
+			return fmt.Errorf("error setting power policy of host '%s' to '%s': %s", host.Name(), shortName, err)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("host '%s' does not support power policy '%s', supported policies are: %s", host.Name(), shortName, strings.Join(available, ", "))
+}
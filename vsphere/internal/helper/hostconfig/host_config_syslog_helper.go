@@ -3,8 +3,11 @@ package hostconfig
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/object"
@@ -14,9 +17,54 @@ import (
 const (
 	SyslogHostKey     = "Syslog.global.logHost"
 	SyslogLogLevelKey = "Syslog.global.logLevel"
+
+	SyslogDefaultRotateKey         = "Syslog.global.defaultRotate"
+	SyslogDefaultSizeKey           = "Syslog.global.defaultSize"
+	SyslogLogDirKey                = "Syslog.global.logDir"
+	SyslogLogDirUniqueKey          = "Syslog.global.logDirUnique"
+	SyslogAuditStorageEnableKey    = "Syslog.global.auditRecord.storageEnable"
+	SyslogAuditStorageCapacityKey  = "Syslog.global.auditRecord.storageCapacity"
+	SyslogAuditStorageDirectoryKey = "Syslog.global.auditRecord.storageDirectory"
+	SyslogAuditRemoteEnableKey     = "Syslog.global.auditRecord.remoteEnable"
+	SyslogLoggersKeyPrefix         = "Syslog.loggers."
+	syslogLoggerRotateKeySuffix    = ".rotate"
+	syslogLoggerSizeKeySuffix      = ".size"
+)
+
+// SyslogOptionAttributes maps the syslog attributes that are read back from
+// the host to their option keys.  Attributes not set in config are computed
+// from the host so that importing brings in the full syslog configuration
+var SyslogOptionAttributes = map[string]string{
+	"default_rotate":                 SyslogDefaultRotateKey,
+	"default_size":                   SyslogDefaultSizeKey,
+	"log_dir":                        SyslogLogDirKey,
+	"log_dir_unique":                 SyslogLogDirUniqueKey,
+	"audit_record_storage_enabled":   SyslogAuditStorageEnableKey,
+	"audit_record_storage_capacity":  SyslogAuditStorageCapacityKey,
+	"audit_record_storage_directory": SyslogAuditStorageDirectoryKey,
+	"audit_record_remote_enabled":    SyslogAuditRemoteEnableKey,
+}
+
+// SyslogLoggers selects the loggers HostConfigSyslogRead reads from the host
+type SyslogLoggers int
+
+const (
+	// SyslogLoggersDeclared reads back the loggers already in d
+	SyslogLoggersDeclared SyslogLoggers = iota
+	// SyslogLoggersChanged reads the loggers whose settings differ from the
+	// host defaults
+	SyslogLoggersChanged
+	// SyslogLoggersAll reads every logger on the host
+	SyslogLoggersAll
 )
 
-func HostConfigSyslogRead(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem) error {
+func syslogLoggerKeys(name string) (string, string) {
+	return SyslogLoggersKeyPrefix + name + syslogLoggerRotateKeySuffix, SyslogLoggersKeyPrefix + name + syslogLoggerSizeKeySuffix
+}
+
+// HostConfigSyslogRead reads the syslog settings of the host into d along with
+// the loggers selected by loggers
+func HostConfigSyslogRead(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem, loggers SyslogLoggers) error {
 	optManager, err := GetOptionManager(client, host)
 	if err != nil {
 		return err
@@ -43,6 +91,42 @@ func HostConfigSyslogRead(d *schema.ResourceData, client *govmomi.Client, host *
 		d.Set("log_level", logLvlOpts[0].GetOptionValue().Value)
 	}
 
+	keys := make([]string, 0, len(SyslogOptionAttributes))
+	for _, key := range SyslogOptionAttributes {
+		keys = append(keys, key)
+	}
+
+	values, err := QueryOptionValues(optManager, keys)
+	if err != nil {
+		return fmt.Errorf("error querying syslog settings on host '%s': %s", host.Name(), err)
+	}
+
+	for attr, key := range SyslogOptionAttributes {
+		if value, ok := values[key]; ok {
+			d.Set(attr, value)
+		}
+	}
+
+	loggerList, err := readHostConfigSyslogLoggers(d, optManager, loggers)
+	if err != nil {
+		return fmt.Errorf("error querying syslog loggers on host '%s': %s", host.Name(), err)
+	}
+
+	d.Set("logger", loggerList)
+
+	// The ca certificate can't be read back as a single value so only check
+	// that the one in state is still trusted by the host
+	if caPEM, ok := d.GetOk("ca_certificate"); ok {
+		found, err := HostHasCACertificate(client, host, caPEM.(string))
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			d.Set("ca_certificate", "")
+		}
+	}
+
 	return nil
 }
 
@@ -61,6 +145,14 @@ func UpdateHostConfigSyslog(d *schema.ResourceData, client *govmomi.Client, host
 		logLvl = d.Get("log_level").(string)
 	}
 
+	// Update the ca certificate before the log host so that the host trusts
+	// the remote collector by the time it starts forwarding over tls
+	if isDelete || d.HasChange("ca_certificate") {
+		if err = updateHostConfigSyslogCACertificate(d, client, host, isDelete); err != nil {
+			return err
+		}
+	}
+
 	optValues := []*types.OptionValue{
 		{
 			Key:   SyslogHostKey,
@@ -84,5 +176,208 @@ func UpdateHostConfigSyslog(d *schema.ResourceData, client *govmomi.Client, host
 		}
 	}
 
+	values, resetKeys := hostConfigSyslogOptionValues(d, isDelete)
+
+	if len(resetKeys) > 0 {
+		defaults, err := QueryOptionDefaults(optManager, resetKeys)
+		if err != nil {
+			return fmt.Errorf("error querying syslog defaults for host '%s': %s", host.Name(), err)
+		}
+
+		for key, value := range defaults {
+			values[key] = OptionValueString(value)
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	current, err := QueryOptionValues(optManager, keys)
+	if err != nil {
+		return fmt.Errorf("error querying syslog settings on host '%s': %s", host.Name(), err)
+	}
+
+	// Only send the options that differ from the host as some of them, such as
+	// the log directory, restart the syslog service when updated
+	for key, value := range values {
+		if currentVal, ok := current[key]; ok && OptionValueString(currentVal) == value {
+			delete(values, key)
+		}
+	}
+
+	if err = UpdateOptionValues(optManager, current, values); err != nil {
+		return fmt.Errorf("error trying to update syslog settings for host '%s': %s", host.Name(), err)
+	}
+
 	return nil
 }
+
+// updateHostConfigSyslogCACertificate adds ca_certificate to the trusted ca
+// certificates of the host unless the host already trusts it.  The previous ca
+// certificate is only removed when it was added by this resource, so that ca
+// certificates trusted for other reasons, such as the vmca root, stay in place
+func updateHostConfigSyslogCACertificate(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem, isDelete bool) error {
+	oldCA, newCA := d.GetChange("ca_certificate")
+	oldAdded, _ := d.GetChange("ca_certificate_added")
+	oldPEM, newPEM := oldCA.(string), newCA.(string)
+
+	if isDelete {
+		newPEM = ""
+	}
+
+	removePEM := ""
+	if oldAdded.(bool) && oldPEM != "" && !certificate.Equal(oldPEM, newPEM) {
+		removePEM = oldPEM
+	}
+
+	addPEM := ""
+	added := false
+
+	if newPEM != "" {
+		if oldAdded.(bool) && oldPEM != "" && certificate.Equal(oldPEM, newPEM) {
+			added = true
+		} else {
+			trusted, err := HostHasCACertificate(client, host, newPEM)
+			if err != nil {
+				return err
+			}
+
+			if !trusted {
+				addPEM = newPEM
+				added = true
+			}
+		}
+	}
+
+	if err := UpdateHostCACertificate(client, host, removePEM, addPEM); err != nil {
+		return err
+	}
+
+	d.Set("ca_certificate_added", added)
+	return nil
+}
+
+// hostConfigSyslogOptionValues returns the syslog option values to set from
+// d along with the keys that should be reset to their defaults.  These are the
+// keys of the attributes and loggers removed from config, or every key that
+// was set in config on delete
+func hostConfigSyslogOptionValues(d *schema.ResourceData, isDelete bool) (map[string]string, []string) {
+	values := make(map[string]string)
+	resetKeys := make([]string, 0)
+
+	oldLoggers, newLoggers := d.GetChange("logger")
+	oldAttrs, newAttrs := d.GetChange("configured_attributes")
+
+	if isDelete {
+		for _, attr := range oldAttrs.(*schema.Set).List() {
+			if key, ok := SyslogOptionAttributes[attr.(string)]; ok {
+				resetKeys = append(resetKeys, key)
+			}
+		}
+
+		for _, v := range oldLoggers.(*schema.Set).List() {
+			rotateKey, sizeKey := syslogLoggerKeys(v.(map[string]interface{})["name"].(string))
+			resetKeys = append(resetKeys, rotateKey, sizeKey)
+		}
+
+		return values, resetKeys
+	}
+
+	for attr, key := range SyslogOptionAttributes {
+		if value, ok := d.GetOkExists(attr); ok {
+			values[key] = OptionValueString(value)
+		}
+	}
+
+	for _, attr := range oldAttrs.(*schema.Set).Difference(newAttrs.(*schema.Set)).List() {
+		if key, ok := SyslogOptionAttributes[attr.(string)]; ok {
+			delete(values, key)
+			resetKeys = append(resetKeys, key)
+		}
+	}
+
+	declared := make(map[string]bool)
+
+	for _, v := range newLoggers.(*schema.Set).List() {
+		logger := v.(map[string]interface{})
+		name := logger["name"].(string)
+		rotateKey, sizeKey := syslogLoggerKeys(name)
+
+		declared[name] = true
+		values[rotateKey] = OptionValueString(logger["rotate"])
+		values[sizeKey] = OptionValueString(logger["size"])
+	}
+
+	for _, v := range oldLoggers.(*schema.Set).List() {
+		name := v.(map[string]interface{})["name"].(string)
+
+		if !declared[name] {
+			rotateKey, sizeKey := syslogLoggerKeys(name)
+			resetKeys = append(resetKeys, rotateKey, sizeKey)
+		}
+	}
+
+	return values, resetKeys
+}
+
+func readHostConfigSyslogLoggers(d *schema.ResourceData, optManager *object.OptionManager, loggers SyslogLoggers) ([]interface{}, error) {
+	keys := make([]string, 0)
+
+	if loggers != SyslogLoggersDeclared {
+		keys = append(keys, SyslogLoggersKeyPrefix)
+	} else if v, ok := d.GetOk("logger"); ok {
+		for _, l := range v.(*schema.Set).List() {
+			rotateKey, sizeKey := syslogLoggerKeys(l.(map[string]interface{})["name"].(string))
+			keys = append(keys, rotateKey, sizeKey)
+		}
+	}
+
+	values, err := QueryOptionValues(optManager, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := make(map[string]interface{})
+	if loggers == SyslogLoggersChanged {
+		if defaults, err = QueryOptionDefaults(optManager, keys); err != nil {
+			return nil, err
+		}
+	}
+
+	names := make([]string, 0)
+	for key := range values {
+		if strings.HasSuffix(key, syslogLoggerRotateKeySuffix) {
+			names = append(names, strings.TrimSuffix(strings.TrimPrefix(key, SyslogLoggersKeyPrefix), syslogLoggerRotateKeySuffix))
+		}
+	}
+	sort.Strings(names)
+
+	result := make([]interface{}, 0, len(names))
+
+	for _, name := range names {
+		rotateKey, sizeKey := syslogLoggerKeys(name)
+		size, ok := values[sizeKey]
+		if !ok {
+			continue
+		}
+
+		if loggers == SyslogLoggersChanged && syslogOptionIsDefault(defaults, rotateKey, values[rotateKey]) && syslogOptionIsDefault(defaults, sizeKey, size) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":   name,
+			"rotate": values[rotateKey],
+			"size":   size,
+		})
+	}
+
+	return result, nil
+}
+
+func syslogOptionIsDefault(defaults map[string]interface{}, key string, value interface{}) bool {
+	def, ok := defaults[key]
+	return ok && OptionValueString(def) == OptionValueString(value)
+}
@@ -0,0 +1,155 @@
+package hostconfig
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// QueryOptionValues queries the given keys from the option manager and returns
+// them keyed by option key.  A key ending in '.' returns all options under it.
+// Keys the option manager does not know about are left out of the result
+func QueryOptionValues(optManager *object.OptionManager, keys []string) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	values := make(map[string]interface{})
+
+	for _, key := range keys {
+		opts, err := optManager.Query(ctx, key)
+		if err != nil {
+			if viapi.IsInvalidNameError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("error querying option '%s': %s", key, err)
+		}
+
+		for _, opt := range opts {
+			ov := opt.GetOptionValue()
+			values[ov.Key] = ov.Value
+		}
+	}
+
+	return values, nil
+}
+
+// QueryOptionDefaults returns the default values of the given keys from the
+// supported options of the option manager.  A key ending in '.' returns the
+// defaults of all options under it.  Keys without a default are left out
+func QueryOptionDefaults(optManager *object.OptionManager, keys []string) (map[string]interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var om mo.OptionManager
+	if err := optManager.Properties(ctx, optManager.Reference(), []string{"supportedOption"}, &om); err != nil {
+		return nil, fmt.Errorf("error retrieving supported options: %s", err)
+	}
+
+	defaults := make(map[string]interface{})
+
+	for _, def := range om.SupportedOption {
+		for _, key := range keys {
+			if def.Key != key && !(strings.HasSuffix(key, ".") && strings.HasPrefix(def.Key, key)) {
+				continue
+			}
+
+			if value, ok := optionDefaultValue(def.OptionType); ok {
+				defaults[def.Key] = value
+			}
+		}
+	}
+
+	return defaults, nil
+}
+
+func optionDefaultValue(optType types.BaseOptionType) (interface{}, bool) {
+	switch t := optType.(type) {
+	case *types.IntOption:
+		return t.DefaultValue, true
+	case *types.LongOption:
+		return t.DefaultValue, true
+	case *types.FloatOption:
+		return t.DefaultValue, true
+	case *types.BoolOption:
+		return t.DefaultValue, true
+	case *types.StringOption:
+		return t.DefaultValue, true
+	case *types.ChoiceOption:
+		if int(t.DefaultIndex) < len(t.ChoiceInfo) {
+			return t.ChoiceInfo[t.DefaultIndex].GetElementDescription().Key, true
+		}
+	}
+
+	return nil, false
+}
+
+// OptionValueString formats an option value the way it is stored in state
+func OptionValueString(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+// CoerceOptionValue converts the string value from config into the same type
+// as the current value of the option as options reject values of other types
+func CoerceOptionValue(current interface{}, value string) (interface{}, error) {
+	switch current.(type) {
+	case int32:
+		v, err := strconv.ParseInt(value, 10, 32)
+		return int32(v), err
+	case int64:
+		return strconv.ParseInt(value, 10, 64)
+	case int:
+		return strconv.Atoi(value)
+	case bool:
+		return strconv.ParseBool(value)
+	case float32:
+		v, err := strconv.ParseFloat(value, 32)
+		return float32(v), err
+	case float64:
+		return strconv.ParseFloat(value, 64)
+	default:
+		return value, nil
+	}
+}
+
+// UpdateOptionValues coerces the given values to the type of the current
+// options and updates them.  Options without a current value are set as strings
+func UpdateOptionValues(optManager *object.OptionManager, current map[string]interface{}, values map[string]string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	optValues := make([]types.BaseOptionValue, 0, len(values))
+
+	for key, value := range values {
+		v, err := CoerceOptionValue(current[key], value)
+		if err != nil {
+			return fmt.Errorf("error converting value '%s' for option '%s' to type %T: %s", value, key, current[key], err)
+		}
+
+		optValues = append(optValues, &types.OptionValue{
+			Key:   key,
+			Value: v,
+		})
+	}
+
+	if len(optValues) == 0 {
+		return nil
+	}
+
+	if err := optManager.Update(ctx, optValues); err != nil {
+		return fmt.Errorf("error updating options: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package hostconfig
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCoerceOptionValue(t *testing.T) {
+	cases := []struct {
+		name      string
+		current   interface{}
+		value     string
+		expected  interface{}
+		expectErr bool
+	}{
+		{name: "int32", current: int32(1), value: "30", expected: int32(30)},
+		{name: "int64", current: int64(1), value: "30", expected: int64(30)},
+		{name: "bool", current: false, value: "true", expected: true},
+		{name: "float64", current: float64(1), value: "1.5", expected: float64(1.5)},
+		{name: "string", current: "old", value: "new", expected: "new"},
+		{name: "unknown option", current: nil, value: "new", expected: "new"},
+		{name: "invalid int", current: int32(1), value: "abc", expectErr: true},
+		{name: "invalid bool", current: true, value: "abc", expectErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := CoerceOptionValue(tc.current, tc.value)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("bad: %s", err)
+			}
+			if !reflect.DeepEqual(tc.expected, actual) {
+				t.Fatalf("expected %#v, got %#v", tc.expected, actual)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
@@ -19,6 +20,7 @@ import (
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -377,3 +379,69 @@ func GetConnectionState(host *object.HostSystem) (types.HostSystemConnectionStat
 
 	return hostProps.Runtime.ConnectionState, nil
 }
+
+// RebootHost reboots a host that is in maintenance mode and waits for it to
+// reconnect to vCenter Server.  Waiting for the host requires vCenter Server as
+// the connection to a direct ESXi host is lost when it reboots.
+func RebootHost(host *object.HostSystem, timeout time.Duration) error {
+	if err := viapi.VimValidateVirtualCenter(host.Client()); err != nil {
+		return fmt.Errorf("rebooting host %q requires vCenter Server: %s", host.Name(), err)
+	}
+
+	log.Printf("[DEBUG] Host %q is rebooting", host.Name())
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req := types.RebootHost_Task{
+		This:  host.Reference(),
+		Force: false,
+	}
+	res, err := methods.RebootHost_Task(ctx, host.Client(), &req)
+	if err != nil {
+		return err
+	}
+
+	task := object.NewTask(host.Client(), res.Returnval)
+	if err = task.Wait(ctx); err != nil {
+		return fmt.Errorf("error while rebooting host(%s): %s", host.Reference(), err)
+	}
+
+	connected := string(types.HostSystemConnectionStateConnected)
+	notConnected := []string{
+		string(types.HostSystemConnectionStateNotResponding),
+		string(types.HostSystemConnectionStateDisconnected),
+	}
+
+	// Wait for vCenter Server to notice the host went down before waiting for
+	// it to come back so that the host is not reported connected right away
+	if err = waitForConnectionState(host, []string{connected}, notConnected, timeout); err != nil {
+		return err
+	}
+
+	return waitForConnectionState(host, notConnected, []string{connected}, timeout)
+}
+
+func waitForConnectionState(host *object.HostSystem, pending, target []string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			state, err := GetConnectionState(host)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return state, string(state), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for host(%s) to reach connection state %v: %s", host.Reference(), target, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi/ssoadmin"
+	"github.com/vmware/govmomi/ssoadmin/methods"
+	"github.com/vmware/govmomi/ssoadmin/types"
+)
+
+// CheckClient makes sure the sso client was created, which only happens when
+// connected to a vcenter
+func CheckClient(client *ssoadmin.Client) error {
+	if client == nil {
+		return fmt.Errorf("sso is only available when connected to vcenter")
+	}
+
+	return nil
+}
+
+// PrincipalID splits a 'name@domain' principal into its id.  Principals
+// without a domain are in the sso system domain such as vsphere.local
+func PrincipalID(client *ssoadmin.Client, principal string) types.PrincipalId {
+	p := strings.SplitN(principal, "@", 2)
+	id := types.PrincipalId{Name: p[0], Domain: client.Domain}
+
+	if len(p) == 2 {
+		id.Domain = p[1]
+	}
+
+	return id
+}
+
+// PrincipalName formats the principal id as 'name@domain'
+func PrincipalName(id types.PrincipalId) string {
+	return id.Name + "@" + id.Domain
+}
+
+// SetUserEnabled enables or disables the sso user
+func SetUserEnabled(client *ssoadmin.Client, id types.PrincipalId, enabled bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var err error
+
+	if enabled {
+		_, err = methods.EnableUserAccount(ctx, client, &types.EnableUserAccount{
+			This:   client.ServiceContent.PrincipalManagementService,
+			UserId: id,
+		})
+	} else {
+		_, err = methods.DisableUserAccount(ctx, client, &types.DisableUserAccount{
+			This:   client.ServiceContent.PrincipalManagementService,
+			UserId: id,
+		})
+	}
+
+	if err != nil {
+		return fmt.Errorf("error setting enabled to %t for sso user '%s': %s", enabled, PrincipalName(id), err)
+	}
+
+	return nil
+}
+
+// UnlockUser unlocks an sso user locked by the lockout policy
+func UnlockUser(client *ssoadmin.Client, id types.PrincipalId) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	_, err := methods.UnlockUserAccount(ctx, client, &types.UnlockUserAccount{
+		This:   client.ServiceContent.PrincipalManagementService,
+		UserId: id,
+	})
+	if err != nil {
+		return fmt.Errorf("error unlocking sso user '%s': %s", PrincipalName(id), err)
+	}
+
+	return nil
+}
+
+// GroupMembers returns the users and groups that are direct members of the
+// sso group formatted as 'name@domain'
+func GroupMembers(client *ssoadmin.Client, group string) ([]string, []string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	users, err := client.FindUsersInGroup(ctx, group, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving users in sso group '%s': %s", group, err)
+	}
+
+	groups, err := client.FindGroupsInGroup(ctx, group, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving groups in sso group '%s': %s", group, err)
+	}
+
+	userNames := make([]string, 0, len(users))
+	for _, u := range users {
+		userNames = append(userNames, PrincipalName(u.Id))
+	}
+
+	groupNames := make([]string, 0, len(groups))
+	for _, g := range groups {
+		groupNames = append(groupNames, PrincipalName(g.Id))
+	}
+
+	return userNames, groupNames, nil
+}
+
+// AddGroupMembers adds the users and groups, given as 'name@domain', to the
+// sso group
+func AddGroupMembers(client *ssoadmin.Client, group string, users, groups []string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	if len(users) > 0 {
+		if err := client.AddUsersToGroup(ctx, group, principalIDs(client, users)...); err != nil {
+			return fmt.Errorf("error adding users to sso group '%s': %s", group, err)
+		}
+	}
+
+	if len(groups) > 0 {
+		if err := client.AddGroupsToGroup(ctx, group, principalIDs(client, groups)...); err != nil {
+			return fmt.Errorf("error adding groups to sso group '%s': %s", group, err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveGroupMembers removes the principals, given as 'name@domain', from the
+// sso group
+func RemoveGroupMembers(client *ssoadmin.Client, group string, principals []string) error {
+	if len(principals) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	_, err := methods.RemovePrincipalsFromLocalGroup(ctx, client, &types.RemovePrincipalsFromLocalGroup{
+		This:          client.ServiceContent.PrincipalManagementService,
+		PrincipalsIds: principalIDs(client, principals),
+		GroupName:     group,
+	})
+	if err != nil {
+		return fmt.Errorf("error removing members from sso group '%s': %s", group, err)
+	}
+
+	return nil
+}
+
+func principalIDs(client *ssoadmin.Client, principals []string) []types.PrincipalId {
+	ids := make([]types.PrincipalId, 0, len(principals))
+	for _, p := range principals {
+		ids = append(ids, PrincipalID(client, p))
+	}
+
+	return ids
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi/ssoadmin"
+	"github.com/vmware/govmomi/ssoadmin/types"
+	"github.com/vmware/govmomi/vim25/soap"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+)
+
+// LdapIdentitySourceDetails are the details of an ldap identity source.  The
+// govmomi version of this type doesn't have the certificates used to trust
+// ldaps servers so the register and update requests are defined here
+type LdapIdentitySourceDetails struct {
+	FriendlyName string   `xml:"friendlyName"`
+	UserBaseDn   string   `xml:"userBaseDn,omitempty"`
+	GroupBaseDn  string   `xml:"groupBaseDn,omitempty"`
+	PrimaryURL   string   `xml:"primaryUrl"`
+	FailoverURL  string   `xml:"failoverUrl,omitempty"`
+	Certificates []string `xml:"certificates,omitempty"`
+}
+
+type registerLdapRequest struct {
+	This               vimtypes.ManagedObjectReference                                         `xml:"_this"`
+	ServerType         string                                                                  `xml:"serverType"`
+	DomainName         string                                                                  `xml:"domainName"`
+	DomainAlias        string                                                                  `xml:"domainAlias,omitempty"`
+	Details            LdapIdentitySourceDetails                                               `xml:"details"`
+	AuthenticationType string                                                                  `xml:"authenticationType"`
+	AuthnCredentials   *types.SsoAdminIdentitySourceManagementServiceAuthenticationCredentails `xml:"authnCredentials,omitempty"`
+}
+
+type registerLdapResponse struct{}
+
+type registerLdapBody struct {
+	Req    *registerLdapRequest  `xml:"urn:sso RegisterLdap,omitempty"`
+	Res    *registerLdapResponse `xml:"urn:sso RegisterLdapResponse,omitempty"`
+	Fault_ *soap.Fault           `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *registerLdapBody) Fault() *soap.Fault { return b.Fault_ }
+
+type updateLdapRequest struct {
+	This       vimtypes.ManagedObjectReference `xml:"_this"`
+	DomainName string                          `xml:"name"`
+	Details    LdapIdentitySourceDetails       `xml:"details"`
+}
+
+type updateLdapResponse struct{}
+
+type updateLdapBody struct {
+	Req    *updateLdapRequest  `xml:"urn:sso UpdateLdap,omitempty"`
+	Res    *updateLdapResponse `xml:"urn:sso UpdateLdapResponse,omitempty"`
+	Fault_ *soap.Fault         `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *updateLdapBody) Fault() *soap.Fault { return b.Fault_ }
+
+type registerActiveDirectoryRequest struct {
+	This                      vimtypes.ManagedObjectReference                                         `xml:"_this"`
+	DomainName                string                                                                  `xml:"domainName"`
+	AuthenticationCredentials *types.SsoAdminIdentitySourceManagementServiceAuthenticationCredentails `xml:"authenticationCredentials,omitempty"`
+}
+
+type registerActiveDirectoryResponse struct{}
+
+type registerActiveDirectoryBody struct {
+	Req    *registerActiveDirectoryRequest  `xml:"urn:sso RegisterActiveDirectory,omitempty"`
+	Res    *registerActiveDirectoryResponse `xml:"urn:sso RegisterActiveDirectoryResponse,omitempty"`
+	Fault_ *soap.Fault                      `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *registerActiveDirectoryBody) Fault() *soap.Fault { return b.Fault_ }
+
+// LdapCertificates converts PEM encoded certificates to the base64 encoded
+// DER format sso expects
+func LdapCertificates(certs []string) ([]string, error) {
+	ldapCerts := make([]string, 0, len(certs))
+
+	for _, certPEM := range certs {
+		cert, err := certificate.Parse(certPEM)
+		if err != nil {
+			return nil, err
+		}
+
+		ldapCerts = append(ldapCerts, base64.StdEncoding.EncodeToString(cert.Raw))
+	}
+
+	return ldapCerts, nil
+}
+
+// RegisterLdap registers an ldap identity source authenticating with the
+// given credentials
+func RegisterLdap(client *ssoadmin.Client, serverType, name, alias string, details LdapIdentitySourceDetails, auth *types.SsoAdminIdentitySourceManagementServiceAuthenticationCredentails) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var reqBody, resBody registerLdapBody
+
+	reqBody.Req = &registerLdapRequest{
+		This:               client.ServiceContent.IdentitySourceManagementService,
+		ServerType:         serverType,
+		DomainName:         name,
+		DomainAlias:        alias,
+		Details:            details,
+		AuthenticationType: "password",
+		AuthnCredentials:   auth,
+	}
+
+	if err := client.RoundTrip(ctx, &reqBody, &resBody); err != nil {
+		return fmt.Errorf("error registering ldap identity source '%s': %s", name, err)
+	}
+
+	return nil
+}
+
+// UpdateLdap updates the details of an ldap identity source
+func UpdateLdap(client *ssoadmin.Client, name string, details LdapIdentitySourceDetails) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var reqBody, resBody updateLdapBody
+
+	reqBody.Req = &updateLdapRequest{
+		This:       client.ServiceContent.IdentitySourceManagementService,
+		DomainName: name,
+		Details:    details,
+	}
+
+	if err := client.RoundTrip(ctx, &reqBody, &resBody); err != nil {
+		return fmt.Errorf("error updating ldap identity source '%s': %s", name, err)
+	}
+
+	return nil
+}
+
+// RegisterActiveDirectory registers the active directory domain vcenter is
+// joined to as an integrated windows authentication identity source.  Without
+// credentials the machine account of vcenter is used
+func RegisterActiveDirectory(client *ssoadmin.Client, name string, auth *types.SsoAdminIdentitySourceManagementServiceAuthenticationCredentails) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	var reqBody, resBody registerActiveDirectoryBody
+
+	reqBody.Req = &registerActiveDirectoryRequest{
+		This:                      client.ServiceContent.IdentitySourceManagementService,
+		DomainName:                name,
+		AuthenticationCredentials: auth,
+	}
+
+	if err := client.RoundTrip(ctx, &reqBody, &resBody); err != nil {
+		return fmt.Errorf("error registering active directory identity source '%s': %s", name, err)
+	}
+
+	return nil
+}
+
+// IsDefaultDomain returns whether the domain is the default domain for logins
+// without a domain
+func IsDefaultDomain(client *ssoadmin.Client, name string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	domains, err := client.GetDefaultDomains(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error retrieving default sso domains: %s", err)
+	}
+
+	return len(domains) > 0 && domains[0] == name, nil
+}
+
+// SetDefaultDomain makes the domain the default domain for logins without a
+// domain
+func SetDefaultDomain(client *ssoadmin.Client, name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	if err := client.SetDefaultDomains(ctx, name); err != nil {
+		return fmt.Errorf("error setting default sso domain to '%s': %s", name, err)
+	}
+
+	return nil
+}
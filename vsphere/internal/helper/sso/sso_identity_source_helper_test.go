@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLdapCertificates(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "ldaps.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	certs, err := LdapCertificates([]string{string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))})
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	expected := base64.StdEncoding.EncodeToString(der)
+	if len(certs) != 1 || certs[0] != expected {
+		t.Fatalf("expected %q, got %q", expected, certs)
+	}
+
+	out, err := xml.Marshal(LdapIdentitySourceDetails{PrimaryURL: "ldaps://ldaps.example.com", Certificates: certs})
+	if err != nil {
+		t.Fatalf("bad: %s", err)
+	}
+
+	if !strings.Contains(string(out), "<certificates>"+expected+"</certificates>") {
+		t.Fatalf("expected certificates in encoded details, got %s", out)
+	}
+
+	if _, err = LdapCertificates([]string{"not a certificate"}); err == nil {
+		t.Fatalf("expected error for invalid certificate")
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sso
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/vmware/govmomi/ssoadmin"
+	"github.com/vmware/govmomi/ssoadmin/methods"
+	"github.com/vmware/govmomi/ssoadmin/types"
+)
+
+// TokenPolicy is the token policy of the sso system domain.  Lifetimes and
+// clock tolerance are in milliseconds
+type TokenPolicy struct {
+	MaxBearerTokenLifetime int64
+	MaxHoKTokenLifetime    int64
+	ClockTolerance         int64
+	DelegationCount        int32
+	RenewCount             int32
+}
+
+// GetPasswordPolicy returns the password policy of the sso system domain
+func GetPasswordPolicy(client *ssoadmin.Client) (*types.AdminPasswordPolicy, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	policy, err := client.GetLocalPasswordPolicy(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sso password policy: %s", err)
+	}
+
+	return policy, nil
+}
+
+// UpdatePasswordPolicy replaces the password policy of the sso system domain
+func UpdatePasswordPolicy(client *ssoadmin.Client, policy types.AdminPasswordPolicy) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	if err := client.UpdateLocalPasswordPolicy(ctx, policy); err != nil {
+		return fmt.Errorf("error updating sso password policy: %s", err)
+	}
+
+	return nil
+}
+
+// GetLockoutPolicy returns the lockout policy of the sso system domain
+func GetLockoutPolicy(client *ssoadmin.Client) (*types.AdminLockoutPolicy, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	res, err := methods.GetLockoutPolicy(ctx, client, &types.GetLockoutPolicy{
+		This: client.ServiceContent.LockoutPolicyService,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sso lockout policy: %s", err)
+	}
+
+	return &res.Returnval, nil
+}
+
+// UpdateLockoutPolicy replaces the lockout policy of the sso system domain
+func UpdateLockoutPolicy(client *ssoadmin.Client, policy types.AdminLockoutPolicy) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	_, err := methods.UpdateLockoutPolicy(ctx, client, &types.UpdateLockoutPolicy{
+		This:   client.ServiceContent.LockoutPolicyService,
+		Policy: policy,
+	})
+	if err != nil {
+		return fmt.Errorf("error updating sso lockout policy: %s", err)
+	}
+
+	return nil
+}
+
+// GetTokenPolicy returns the token policy of the sso system domain
+func GetTokenPolicy(client *ssoadmin.Client) (*TokenPolicy, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	this := client.ServiceContent.ConfigurationManagementService
+
+	bearer, err := methods.GetMaximumBearerTokenLifetime(ctx, client, &types.GetMaximumBearerTokenLifetime{This: this})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sso maximum bearer token lifetime: %s", err)
+	}
+
+	hok, err := methods.GetMaximumHoKTokenLifetime(ctx, client, &types.GetMaximumHoKTokenLifetime{This: this})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sso maximum holder-of-key token lifetime: %s", err)
+	}
+
+	clock, err := methods.GetClockTolerance(ctx, client, &types.GetClockTolerance{This: this})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sso clock tolerance: %s", err)
+	}
+
+	delegation, err := methods.GetDelegationCount(ctx, client, &types.GetDelegationCount{This: this})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sso token delegation count: %s", err)
+	}
+
+	renew, err := methods.GetRenewCount(ctx, client, &types.GetRenewCount{This: this})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sso token renew count: %s", err)
+	}
+
+	return &TokenPolicy{
+		MaxBearerTokenLifetime: bearer.Returnval,
+		MaxHoKTokenLifetime:    hok.Returnval,
+		ClockTolerance:         clock.Returnval,
+		DelegationCount:        delegation.Returnval,
+		RenewCount:             renew.Returnval,
+	}, nil
+}
+
+// UpdateTokenPolicy sets the values of the token policy that differ from the
+// current policy of the sso system domain
+func UpdateTokenPolicy(client *ssoadmin.Client, policy TokenPolicy) error {
+	current, err := GetTokenPolicy(client)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	this := client.ServiceContent.ConfigurationManagementService
+
+	if policy.MaxBearerTokenLifetime != current.MaxBearerTokenLifetime {
+		_, err = methods.SetMaximumBearerTokenLifetime(ctx, client, &types.SetMaximumBearerTokenLifetime{
+			This:        this,
+			MaxLifetime: policy.MaxBearerTokenLifetime,
+		})
+		if err != nil {
+			return fmt.Errorf("error setting sso maximum bearer token lifetime: %s", err)
+		}
+	}
+
+	if policy.MaxHoKTokenLifetime != current.MaxHoKTokenLifetime {
+		_, err = methods.SetMaximumHoKTokenLifetime(ctx, client, &types.SetMaximumHoKTokenLifetime{
+			This:        this,
+			MaxLifetime: policy.MaxHoKTokenLifetime,
+		})
+		if err != nil {
+			return fmt.Errorf("error setting sso maximum holder-of-key token lifetime: %s", err)
+		}
+	}
+
+	if policy.ClockTolerance != current.ClockTolerance {
+		_, err = methods.SetClockTolerance(ctx, client, &types.SetClockTolerance{
+			This:         this,
+			Milliseconds: policy.ClockTolerance,
+		})
+		if err != nil {
+			return fmt.Errorf("error setting sso clock tolerance: %s", err)
+		}
+	}
+
+	if policy.DelegationCount != current.DelegationCount {
+		_, err = methods.SetDelegationCount(ctx, client, &types.SetDelegationCount{
+			This:            this,
+			DelegationCount: policy.DelegationCount,
+		})
+		if err != nil {
+			return fmt.Errorf("error setting sso token delegation count: %s", err)
+		}
+	}
+
+	if policy.RenewCount != current.RenewCount {
+		_, err = methods.SetRenewCount(ctx, client, &types.SetRenewCount{
+			This:       this,
+			RenewCount: policy.RenewCount,
+		})
+		if err != nil {
+			return fmt.Errorf("error setting sso token renew count: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcenterservicestate
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+	"github.com/vmware/govmomi/vapi/rest"
+)
+
+const (
+	ServicesPath = "/vcenter/services"
+
+	StartupTypeAutomatic = "AUTOMATIC"
+	StartupTypeManual    = "MANUAL"
+	StartupTypeDisabled  = "DISABLED"
+
+	StateStarted  = "STARTED"
+	StateStopped  = "STOPPED"
+	StateStarting = "STARTING"
+	StateStopping = "STOPPING"
+)
+
+var (
+	StartupTypeList = []string{
+		StartupTypeAutomatic,
+		StartupTypeManual,
+		StartupTypeDisabled,
+	}
+
+	StateList = []string{
+		StateStarted,
+		StateStopped,
+	}
+)
+
+// GetServices retrieves all of the services for vcenter keyed by service name
+func GetServices(client *rest.Client) (map[string]map[string]interface{}, error) {
+	valRes, err := viapi.RestRequest[[]interface{}](client, http.MethodGet, ServicesPath, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving vcenter services: %s", err)
+	}
+
+	// The rest endpoint represents maps as a list of key/value objects
+	services := make(map[string]map[string]interface{}, len(valRes))
+
+	for _, v := range valRes {
+		entry := v.(map[string]interface{})
+		services[entry["key"].(string)] = entry["value"].(map[string]interface{})
+	}
+
+	return services, nil
+}
+
+// GetService retrieves the info of a single vcenter service
+func GetService(client *rest.Client, key string) (map[string]interface{}, error) {
+	valRes, err := viapi.RestRequest[map[string]interface{}](client, http.MethodGet, ServicesPath+"/"+key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving vcenter service '%s': %s", key, err)
+	}
+
+	return valRes, nil
+}
+
+// SetServiceState sets the startup type of the given service and then starts
+// or stops it, waiting for the service to reach the desired state
+func SetServiceState(client *rest.Client, ss map[string]interface{}, timeout time.Duration) error {
+	key := ss["key"].(string)
+	startupType := ss["startup_type"].(string)
+	state := ss["state"].(string)
+
+	if key == "" {
+		return fmt.Errorf("service key must be set")
+	}
+
+	log.Printf("[INFO] updating vcenter service '%s' with startup type '%s'", key, startupType)
+
+	if _, err := viapi.RestRequest[[]interface{}](
+		client,
+		http.MethodPatch,
+		ServicesPath+"/"+key,
+		map[string]interface{}{
+			"spec": map[string]interface{}{
+				"startup_type": startupType,
+			},
+		},
+	); err != nil {
+		return fmt.Errorf("error updating startup type for vcenter service '%s': %s", key, err)
+	}
+
+	current, err := GetService(client, key)
+	if err != nil {
+		return err
+	}
+
+	if current["state"] == state {
+		return nil
+	}
+
+	action := "start"
+	pending := StateStarting
+
+	if state == StateStopped {
+		action = "stop"
+		pending = StateStopping
+	}
+
+	log.Printf("[INFO] running '%s' on vcenter service '%s'", action, key)
+
+	if _, err = viapi.RestRequest[[]interface{}](
+		client,
+		http.MethodPost,
+		ServicesPath+"/"+key+"/"+action,
+		nil,
+	); err != nil {
+		return fmt.Errorf("error trying to %s vcenter service '%s': %s", action, key, err)
+	}
+
+	return WaitForServiceState(client, key, []string{pending, current["state"].(string)}, state, timeout)
+}
+
+// WaitForServiceState polls the given service until it reaches the target state
+func WaitForServiceState(client *rest.Client, key string, pending []string, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			info, err := GetService(client, key)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return info, info["state"].(string), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+		Delay:      1 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for vcenter service '%s' to reach state '%s': %s", key, target, err)
+	}
+
+	return nil
+}
@@ -13,7 +13,7 @@ import (
 )
 
 // RestRequest makes a rest request to endpoint and returns the given generic format from response
-func RestRequest[T map[string]interface{} | []interface{}](client *rest.Client, method, endpoint string, body interface{}) (T, error) {
+func RestRequest[T map[string]interface{} | []interface{} | string](client *rest.Client, method, endpoint string, body interface{}) (T, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
 	defer cancel()
 
@@ -49,7 +49,8 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("VSPHERE_LICENSE_KEY", nil),
-				Description: "If set, will apply license to vcenter instance",
+				Description: "No longer applied to the vcenter instance.",
+				Deprecated:  "The provider no longer changes licensing. Use the vsphere_license_assignment resource to assign a license to vcenter.",
 			},
 			"vcenter_server": {
 				Type:        schema.TypeString,
@@ -159,6 +160,29 @@ func Provider() *schema.Provider {
 			"vsphere_host_config_syslog":                      resourceVSphereHostConfigSyslog(),
 			"vsphere_host_config_snmp":                        resourceVSphereHostConfigSNMP(),
 			"vsphere_vcenter_snmp":                            resourceVSphereVcenterSNMP(),
+			"vsphere_vcenter_access":                          resourceVSphereVcenterAccess(),
+			"vsphere_vcenter_local_account":                   resourceVSphereVcenterLocalAccount(),
+			"vsphere_vcenter_local_account_policy":            resourceVSphereVcenterLocalAccountPolicy(),
+			"vsphere_vcenter_firewall_rules":                  resourceVSphereVcenterFirewallRules(),
+			"vsphere_vcenter_proxy":                           resourceVSphereVcenterProxy(),
+			"vsphere_vcenter_service_state":                   resourceVSphereVcenterServiceState(),
+			"vsphere_vcenter_advanced_settings":               resourceVSphereVcenterAdvancedSettings(),
+			"vsphere_vcenter_tls_certificate":                 resourceVSphereVcenterTLSCertificate(),
+			"vsphere_vcenter_trusted_root_chain":              resourceVSphereVcenterTrustedRootChain(),
+			"vsphere_host_certificate":                        resourceVSphereHostCertificate(),
+			"vsphere_sso_user":                                resourceVSphereSSOUser(),
+			"vsphere_sso_group":                               resourceVSphereSSOGroup(),
+			"vsphere_sso_policies":                            resourceVSphereSSOPolicies(),
+			"vsphere_host_active_directory":                   resourceVSphereHostActiveDirectory(),
+			"vsphere_host_local_user":                         resourceVSphereHostLocalUser(),
+			"vsphere_global_permission":                       resourceVSphereGlobalPermission(),
+			"vsphere_license_assignment":                      resourceVSphereLicenseAssignment(),
+			"vsphere_host_advanced_settings":                  resourceVSphereHostAdvancedSettings(),
+			"vsphere_host_firewall_ruleset":                   resourceVSphereHostFirewallRuleset(),
+			"vsphere_host_pci_passthrough":                    resourceVSphereHostPciPassthrough(),
+			"vsphere_host_power_policy":                       resourceVSphereHostPowerPolicy(),
+			"vsphere_host_kernel_module":                      resourceVSphereHostKernelModule(),
+			"vsphere_vcenter_tls_csr":                         resourceVSphereVcenterTLSCSR(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -198,6 +222,15 @@ func Provider() *schema.Provider {
 			"vsphere_host_config_snmp":           dataSourceVSphereHostConfigSNMP(),
 			"vsphere_vcenter_snmp":               dataSourceVSphereVcenterSNMP(),
 			"vsphere_vnic_list":                  dataSourceVSphereVnicList(),
+			"vsphere_vcenter_service_state":      dataSourceVSphereVcenterServiceState(),
+			"vsphere_vcenter_health":             dataSourceVSphereVcenterHealth(),
+			"vsphere_vcenter_advanced_settings":  dataSourceVSphereVcenterAdvancedSettings(),
+			"vsphere_sso_policies":               dataSourceVSphereSSOPolicies(),
+			"vsphere_global_permissions":         dataSourceVSphereGlobalPermissions(),
+			"vsphere_privileges":                 dataSourceVSpherePrivileges(),
+			"vsphere_effective_privileges":       dataSourceVSphereEffectivePrivileges(),
+			"vsphere_license_usage":              dataSourceVSphereLicenseUsage(),
+			"vsphere_host_firewall_ruleset":      dataSourceVSphereHostFirewallRuleset(),
 		},
 
 		ConfigureFunc: providerConfigure,
@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/globalpermission"
+)
+
+func resourceVSphereGlobalPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereGlobalPermissionCreate,
+		Read:   resourceVSphereGlobalPermissionRead,
+		Update: resourceVSphereGlobalPermissionUpdate,
+		Delete: resourceVSphereGlobalPermissionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereGlobalPermissionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_or_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "User or group receiving access in the form 'DOMAIN\\name'",
+				DiffSuppressFunc: func(k, old, newValue string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, newValue)
+				},
+			},
+			"is_group": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether user_or_group refers to a group",
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Reference to the role providing the access",
+			},
+			"propagate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the permission propagates down the hierarchy of all vcenters and services",
+			},
+		},
+	}
+}
+
+func resourceVSphereGlobalPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).globalPermissionClient
+	if err := globalpermission.CheckClient(client); err != nil {
+		return err
+	}
+
+	principal := d.Get("user_or_group").(string)
+	group := d.Get("is_group").(bool)
+
+	existing, err := client.Get(principal, group)
+	if err != nil {
+		return err
+	}
+
+	if existing != nil {
+		return fmt.Errorf("global permission of '%s' already exists, import it instead", principal)
+	}
+
+	log.Printf("[INFO] creating global permission of '%s'", principal)
+
+	if err = vsphereGlobalPermissionSet(d, client); err != nil {
+		return err
+	}
+
+	d.SetId(principal)
+	return resourceVSphereGlobalPermissionRead(d, meta)
+}
+
+func resourceVSphereGlobalPermissionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).globalPermissionClient
+	if err := globalpermission.CheckClient(client); err != nil {
+		return err
+	}
+
+	permission, err := client.Get(d.Id(), d.Get("is_group").(bool))
+	if err != nil {
+		return err
+	}
+
+	if permission == nil {
+		log.Printf("[DEBUG] global permission of '%s' not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// vcenter keeps its own case of the principal
+	d.SetId(permission.Principal)
+	flattenGlobalPermission(d, permission)
+	return nil
+}
+
+func resourceVSphereGlobalPermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).globalPermissionClient
+	if err := globalpermission.CheckClient(client); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] updating global permission of '%s'", d.Id())
+
+	// setting the permission again replaces the existing one
+	if err := vsphereGlobalPermissionSet(d, client); err != nil {
+		return err
+	}
+
+	return resourceVSphereGlobalPermissionRead(d, meta)
+}
+
+func resourceVSphereGlobalPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).globalPermissionClient
+	if err := globalpermission.CheckClient(client); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] removing global permission of '%s'", d.Id())
+
+	return client.Remove(d.Id(), d.Get("is_group").(bool))
+}
+
+func resourceVSphereGlobalPermissionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).globalPermissionClient
+	if err := globalpermission.CheckClient(client); err != nil {
+		return nil, err
+	}
+
+	permissions, err := client.List()
+	if err != nil {
+		return nil, err
+	}
+
+	// users are preferred over groups of the same name
+	var found *globalpermission.Permission
+	for i, permission := range permissions {
+		if strings.EqualFold(permission.Principal, d.Id()) && (found == nil || found.Group) {
+			found = &permissions[i]
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("global permission of '%s' not found", d.Id())
+	}
+
+	d.SetId(found.Principal)
+	flattenGlobalPermission(d, found)
+	return []*schema.ResourceData{d}, nil
+}
+
+func vsphereGlobalPermissionSet(d *schema.ResourceData, client *globalpermission.Client) error {
+	roleID, err := strconv.ParseInt(d.Get("role_id").(string), 10, 32)
+	if err != nil {
+		return fmt.Errorf("error while converting role id %s to integer", d.Get("role_id").(string))
+	}
+
+	return client.Set(
+		d.Get("user_or_group").(string),
+		d.Get("is_group").(bool),
+		roleID,
+		d.Get("propagate").(bool),
+	)
+}
+
+func flattenGlobalPermission(d *schema.ResourceData, permission *globalpermission.Permission) {
+	d.Set("user_or_group", permission.Principal)
+	d.Set("is_group", permission.Group)
+	d.Set("propagate", permission.Propagate)
+
+	if len(permission.RoleIDs) > 0 {
+		d.Set("role_id", strconv.FormatInt(permission.RoleIDs[0], 10))
+	}
+}
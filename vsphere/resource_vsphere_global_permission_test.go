@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	globalPermissionResourceName = "vsphere_global_permission.p1"
+	globalPermissionUsername     = "tf-acc-test-global"
+)
+
+func TestAccResourceVSphereGlobalPermission_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccSkipIfEsxi(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereGlobalPermissionRemoved,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereGlobalPermissionConfig("2", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(globalPermissionResourceName, "role_id", "2"),
+					resource.TestCheckResourceAttr(globalPermissionResourceName, "propagate", "true"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereGlobalPermissionConfig("-1", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(globalPermissionResourceName, "role_id", "-1"),
+					resource.TestCheckResourceAttr(globalPermissionResourceName, "propagate", "false"),
+				),
+			},
+			{
+				ResourceName:      globalPermissionResourceName,
+				Config:            testAccResourceVSphereGlobalPermissionConfig("-1", false),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereGlobalPermissionRemoved(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	permissions, err := client.globalPermissionClient.List()
+	if err != nil {
+		return err
+	}
+
+	for _, permission := range permissions {
+		if strings.EqualFold(permission.Principal, fmt.Sprintf(`%s\%s`, client.ssoClient.Domain, globalPermissionUsername)) {
+			return fmt.Errorf("global permission of '%s' still exists", permission.Principal)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceVSphereGlobalPermissionConfig(roleID string, propagate bool) string {
+	return fmt.Sprintf(`
+	resource "vsphere_sso_user" "u1" {
+		username  = "%s"
+		password  = "Tf-Acc-Test-Pa55!"
+		last_name = "User"
+	}
+
+	resource "vsphere_global_permission" "p1" {
+		user_or_group = "${vsphere_sso_user.u1.domain}\\${vsphere_sso_user.u1.username}"
+		role_id       = "%s"
+		propagate     = %t
+	}
+	`,
+		globalPermissionUsername,
+		roleID,
+		propagate,
+	)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/clustercomputeresource"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/customattribute"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/license"
@@ -113,6 +114,13 @@ func resourceVsphereHost() *schema.Resource {
 				Default:      "disabled",
 				ValidateFunc: validation.StringInSlice([]string{"disabled", "normal", "strict"}, true),
 			},
+			"lockdown_exception_users": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Users that keep their permissions on the host when it is in lockdown mode, such as automation accounts that connect to the host directly",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 
 			// Tagging
 			vSphereTagAttributeKey: tagsSchema(),
@@ -271,6 +279,16 @@ func resourceVsphereHostCreate(d *schema.ResourceData, meta interface{}) error {
 
 		hamRef := hostProps.ConfigManager.HostAccessManager.Reference()
 		ham := NewHostAccessManager(client.Client, hamRef)
+
+		// Exempt the users before entering lockdown mode so that they keep
+		// access to the host
+		if resourceVSphereHostLockdownExceptionUsersManaged(d) {
+			err = ham.UpdateLockdownExceptions(context.TODO(), expandHostLockdownExceptionUsers(d.Get("lockdown_exception_users")))
+			if err != nil {
+				return fmt.Errorf("error while updating lockdown exception users for host %s. Error: %s", hostResourceID, err)
+			}
+		}
+
 		err = ham.ChangeLockdownMode(context.TODO(), lockdownMode)
 		if err != nil {
 			return fmt.Errorf("error while changing lockdown mode for host %s. Error: %s", hostResourceID, err)
@@ -348,6 +366,13 @@ func resourceVsphereHostRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("Setting lockdown to %s", lockdownMode)
 	_ = d.Set("lockdown", lockdownMode)
 
+	ham := NewHostAccessManager(client.Client, host.ConfigManager.HostAccessManager.Reference())
+	exceptionUsers, err := ham.QueryLockdownExceptions(context.TODO())
+	if err != nil {
+		return fmt.Errorf("error while retrieving lockdown exception users for host %s. Error: %s", hostResourceID, err)
+	}
+	_ = d.Set("lockdown_exception_users", exceptionUsers)
+
 	licenseKey := d.Get("license").(string)
 	if licenseKey != "" {
 		licFound, err := isLicenseAssigned(client.Client, hs.Reference().Value, licenseKey)
@@ -448,6 +473,15 @@ func resourceVsphereHostUpdate(d *schema.ResourceData, meta interface{}) error {
 		break
 	}
 
+	// Update the exception users before the lockdown mode so that they keep
+	// access to the host when it enters lockdown mode
+	if resourceVSphereHostLockdownExceptionUsersManaged(d) && d.HasChange("lockdown_exception_users") {
+		_, newVal := d.GetChange("lockdown_exception_users")
+		if err := resourceVSphereHostUpdateLockdownExceptionUsers(d, meta, nil, newVal); err != nil {
+			return fmt.Errorf("error while updating lockdown_exception_users: %s", err)
+		}
+	}
+
 	mutableKeys := map[string]func(*schema.ResourceData, interface{}, interface{}, interface{}) error{
 		"license":     resourceVSphereHostUpdateLicense,
 		"cluster":     resourceVSphereHostUpdateCluster,
@@ -598,6 +632,46 @@ func resourceVSphereHostUpdateLockdownMode(d *schema.ResourceData, meta, _, newV
 	return nil
 }
 
+func resourceVSphereHostUpdateLockdownExceptionUsers(d *schema.ResourceData, meta, _, newVal interface{}) error {
+	client := meta.(*Client).vimClient
+	hostResourceID := d.Id()
+
+	host, err := getHostSystemFromID(client, d, hostResourceID)
+	if err != nil {
+		return fmt.Errorf("error while retrieving HostSystem object for host ID %s. Error: %s", hostResourceID, err)
+	}
+
+	var hostProps mo.HostSystem
+	err = host.Properties(context.TODO(), host.ConfigManager().Reference(), []string{"configManager.hostAccessManager"}, &hostProps)
+	if err != nil {
+		return fmt.Errorf("error while retrieving HostSystem properties for host ID %s. Error: %s", hostResourceID, err)
+	}
+
+	ham := NewHostAccessManager(client.Client, hostProps.ConfigManager.HostAccessManager.Reference())
+	err = ham.UpdateLockdownExceptions(context.TODO(), expandHostLockdownExceptionUsers(newVal))
+	if err != nil {
+		return fmt.Errorf("error while updating lockdown exception users for host ID %s. Error: %s", hostResourceID, err)
+	}
+
+	return nil
+}
+
+// resourceVSphereHostLockdownExceptionUsersManaged reports whether
+// lockdown_exception_users is set in config.  The exception users are left
+// alone when it isn't, while an empty set clears them
+func resourceVSphereHostLockdownExceptionUsersManaged(d *schema.ResourceData) bool {
+	return !d.GetRawConfig().GetAttr("lockdown_exception_users").IsNull()
+}
+
+func expandHostLockdownExceptionUsers(v interface{}) []string {
+	users := v.(*schema.Set).List()
+	if len(users) == 0 {
+		return nil
+	}
+
+	return structure.SliceInterfacesToStrings(users)
+}
+
 func resourceVSphereHostUpdateMaintenanceMode(d *schema.ResourceData, meta, _, newVal interface{}) error {
 	client := meta.(*Client).vimClient
 	hostResourceID := d.Id()
@@ -891,3 +965,23 @@ func (h HostAccessManager) ChangeLockdownMode(ctx context.Context, mode types.Ho
 	_, err := methods.ChangeLockdownMode(ctx, h.Client(), &req)
 	return err
 }
+
+func (h HostAccessManager) QueryLockdownExceptions(ctx context.Context) ([]string, error) {
+	req := types.QueryLockdownExceptions{
+		This: h.Reference(),
+	}
+	res, err := methods.QueryLockdownExceptions(ctx, h.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Returnval, nil
+}
+
+func (h HostAccessManager) UpdateLockdownExceptions(ctx context.Context, users []string) error {
+	req := types.UpdateLockdownExceptions{
+		This:  h.Reference(),
+		Users: users,
+	}
+	_, err := methods.UpdateLockdownExceptions(ctx, h.Client(), &req)
+	return err
+}
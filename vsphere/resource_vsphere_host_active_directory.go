@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+)
+
+func resourceVSphereHostActiveDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereHostActiveDirectoryCreate,
+		Read:   resourceVSphereHostActiveDirectoryRead,
+		Update: resourceVSphereHostActiveDirectoryUpdate,
+		Delete: resourceVSphereHostActiveDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereHostActiveDirectoryImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host_system_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Host id of machine to join to the domain",
+				ExactlyOneOf: []string{"hostname"},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Hostname of machine to join to the domain",
+			},
+			"domain_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Active directory domain to join.  Can include an organizational unit such as 'example.com/Computers/ESXi'",
+			},
+			"username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Username of an account allowed to add computers to the domain",
+				ExactlyOneOf: []string{"cam_server"},
+				RequiredWith: []string{"password"},
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the account allowed to add computers to the domain",
+			},
+			"cam_server": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Address of the vSphere Authentication Proxy to join the domain through instead of credentials",
+			},
+			"force_leave": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove permissions of active directory users from the host when leaving the domain.  Leaving fails when such permissions exist and this is false",
+			},
+			"esx_admins_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Active directory group whose members get the administrator role on the host",
+			},
+			"domain_membership_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Health of the domain membership such as 'ok' or 'clientTrustBroken'",
+			},
+			"trusted_domains": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Domains trusted by the joined domain",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceVSphereHostActiveDirectoryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	domain := d.Get("domain_name").(string)
+
+	log.Printf("[INFO] joining host '%s' to domain '%s'", host.Name(), domain)
+
+	// the esx admins group is set first so that its members get access as
+	// soon as the host joins
+	if err = vsphereHostActiveDirectoryUpdateAdminsGroup(d, client, host); err != nil {
+		return err
+	}
+
+	if err = hostconfig.JoinHostDomain(
+		client,
+		host,
+		domain,
+		d.Get("username").(string),
+		d.Get("password").(string),
+		d.Get("cam_server").(string),
+	); err != nil {
+		return err
+	}
+
+	d.SetId(hr.Value)
+	return resourceVSphereHostActiveDirectoryRead(d, meta)
+}
+
+func resourceVSphereHostActiveDirectoryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	joined, err := vsphereHostActiveDirectoryRead(d, client, host)
+	if err != nil {
+		return err
+	}
+
+	if !joined {
+		log.Printf("[DEBUG] host '%s' is not joined to a domain, removing from state", host.Name())
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceVSphereHostActiveDirectoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] updating active directory for host '%s'", host.Name())
+
+	// the credentials and authentication proxy are only used to join so
+	// changes to them are only stored
+	if err = vsphereHostActiveDirectoryUpdateAdminsGroup(d, client, host); err != nil {
+		return err
+	}
+
+	return resourceVSphereHostActiveDirectoryRead(d, meta)
+}
+
+func resourceVSphereHostActiveDirectoryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] removing host '%s' from domain '%s'", host.Name(), d.Get("domain_name").(string))
+
+	return hostconfig.LeaveHostDomain(client, host, d.Get("force_leave").(bool))
+}
+
+func resourceVSphereHostActiveDirectoryImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.CheckIfHostnameOrID(client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	joined, err := vsphereHostActiveDirectoryRead(d, client, host)
+	if err != nil {
+		return nil, err
+	}
+
+	if !joined {
+		return nil, fmt.Errorf("host '%s' is not joined to a domain", host.Name())
+	}
+
+	d.Set(hr.IDName, hr.Value)
+	d.Set("force_leave", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// vsphereHostActiveDirectoryRead reads the domain membership of the host and
+// returns whether the host is joined to a domain
+func vsphereHostActiveDirectoryRead(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem) (bool, error) {
+	info, err := hostconfig.GetHostActiveDirectoryInfo(client, host)
+	if err != nil {
+		return false, err
+	}
+
+	if !info.Enabled {
+		return false, nil
+	}
+
+	// the host reports the domain in its own case and without the
+	// organizational unit it was joined to, so keep the domain from config
+	// when it's the same domain
+	domain := d.Get("domain_name").(string)
+	if !strings.EqualFold(strings.SplitN(domain, "/", 2)[0], info.JoinedDomain) {
+		d.Set("domain_name", info.JoinedDomain)
+	}
+
+	optManager, err := hostconfig.GetOptionManager(client, host)
+	if err != nil {
+		return false, err
+	}
+
+	values, err := hostconfig.QueryOptionValues(optManager, []string{hostconfig.EsxAdminsGroupKey})
+	if err != nil {
+		return false, err
+	}
+
+	d.Set("esx_admins_group", hostconfig.OptionValueString(values[hostconfig.EsxAdminsGroupKey]))
+	d.Set("domain_membership_status", info.DomainMembershipStatus)
+	d.Set("trusted_domains", info.TrustedDomain)
+	return true, nil
+}
+
+func vsphereHostActiveDirectoryUpdateAdminsGroup(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem) error {
+	group, ok := d.GetOk("esx_admins_group")
+	if !ok || !d.HasChange("esx_admins_group") {
+		return nil
+	}
+
+	optManager, err := hostconfig.GetOptionManager(client, host)
+	if err != nil {
+		return err
+	}
+
+	current, err := hostconfig.QueryOptionValues(optManager, []string{hostconfig.EsxAdminsGroupKey})
+	if err != nil {
+		return err
+	}
+
+	return hostconfig.UpdateOptionValues(optManager, current, map[string]string{
+		hostconfig.EsxAdminsGroupKey: group.(string),
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+)
+
+const (
+	hostActiveDirectoryResourceName = "vsphere_host_active_directory.h1"
+)
+
+func TestAccResourceVSphereHostActiveDirectory_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariablesF(t, []string{"TF_VAR_VSPHERE_ESXI1", "TF_VAR_VSPHERE_AD_DOMAIN", "TF_VAR_VSPHERE_AD_USERNAME", "TF_VAR_VSPHERE_AD_PASSWORD"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereHostActiveDirectoryLeft,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereHostActiveDirectoryConfig("ESX Admins"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostActiveDirectoryResourceName, "domain_membership_status", "ok"),
+					resource.TestCheckResourceAttr(hostActiveDirectoryResourceName, "esx_admins_group", "ESX Admins"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereHostActiveDirectoryConfig("tf-acc-test-admins"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostActiveDirectoryResourceName, "esx_admins_group", "tf-acc-test-admins"),
+				),
+			},
+			{
+				ResourceName:            hostActiveDirectoryResourceName,
+				Config:                  testAccResourceVSphereHostActiveDirectoryConfig("tf-acc-test-admins"),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"username", "password"},
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereHostActiveDirectoryLeft(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client).vimClient
+	host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+	if err != nil {
+		return err
+	}
+
+	info, err := hostconfig.GetHostActiveDirectoryInfo(client, host)
+	if err != nil {
+		return err
+	}
+
+	if info.Enabled {
+		return fmt.Errorf("host '%s' is still joined to domain '%s'", host.Name(), info.JoinedDomain)
+	}
+
+	return nil
+}
+
+func testAccResourceVSphereHostActiveDirectoryConfig(adminsGroup string) string {
+	return fmt.Sprintf(`
+	resource "vsphere_host_active_directory" "h1" {
+		hostname         = "%s"
+		domain_name      = "%s"
+		username         = "%s"
+		password         = "%s"
+		esx_admins_group = "%s"
+		force_leave      = true
+	}
+	`,
+		os.Getenv("TF_VAR_VSPHERE_ESXI1"),
+		os.Getenv("TF_VAR_VSPHERE_AD_DOMAIN"),
+		os.Getenv("TF_VAR_VSPHERE_AD_USERNAME"),
+		os.Getenv("TF_VAR_VSPHERE_AD_PASSWORD"),
+		adminsGroup,
+	)
+}
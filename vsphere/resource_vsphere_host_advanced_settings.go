@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/vmware/govmomi/object"
+)
+
+func resourceVSphereHostAdvancedSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereHostAdvancedSettingsCreate,
+		Read:   resourceVSphereHostAdvancedSettingsRead,
+		Update: resourceVSphereHostAdvancedSettingsUpdate,
+		Delete: resourceVSphereHostAdvancedSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereHostAdvancedSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host_system_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Host id of machine to set advanced settings on",
+				ExactlyOneOf: []string{"hostname"},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Hostname of machine to set advanced settings on",
+			},
+			"settings": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Description: "Map of host advanced setting keys to their values.  Values are converted to the type the host reports for the key.  Keys removed from the map are reset to their defaults",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceVSphereHostAdvancedSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] creating advanced settings for host '%s'", host.Name())
+
+	optManager, err := hostconfig.GetOptionManager(client, host)
+	if err != nil {
+		return err
+	}
+
+	if err = vsphereHostAdvancedSettingsUpdate(optManager, d.Get("settings").(map[string]interface{})); err != nil {
+		return fmt.Errorf("error creating advanced settings for host '%s': %s", host.Name(), err)
+	}
+
+	d.SetId(hr.Value)
+	return resourceVSphereHostAdvancedSettingsRead(d, meta)
+}
+
+func resourceVSphereHostAdvancedSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] reading advanced settings for host '%s'", host.Name())
+
+	optManager, err := hostconfig.GetOptionManager(client, host)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0)
+	for key := range d.Get("settings").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	settings, err := vsphereHostAdvancedSettingsRead(optManager, keys)
+	if err != nil {
+		return fmt.Errorf("error retrieving advanced settings for host '%s': %s", host.Name(), err)
+	}
+
+	d.Set("settings", settings)
+	return nil
+}
+
+func resourceVSphereHostAdvancedSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] updating advanced settings for host '%s'", host.Name())
+
+	optManager, err := hostconfig.GetOptionManager(client, host)
+	if err != nil {
+		return err
+	}
+
+	oldVal, newVal := d.GetChange("settings")
+	newSettings := newVal.(map[string]interface{})
+
+	removed := make([]string, 0)
+	for key := range oldVal.(map[string]interface{}) {
+		if _, ok := newSettings[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	if err = vsphereHostAdvancedSettingsReset(optManager, removed); err != nil {
+		return fmt.Errorf("error resetting advanced settings for host '%s': %s", host.Name(), err)
+	}
+
+	if err = vsphereHostAdvancedSettingsUpdate(optManager, newSettings); err != nil {
+		return fmt.Errorf("error updating advanced settings for host '%s': %s", host.Name(), err)
+	}
+
+	return resourceVSphereHostAdvancedSettingsRead(d, meta)
+}
+
+func resourceVSphereHostAdvancedSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] resetting advanced settings for host '%s'", host.Name())
+
+	optManager, err := hostconfig.GetOptionManager(client, host)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0)
+	for key := range d.Get("settings").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	if err = vsphereHostAdvancedSettingsReset(optManager, keys); err != nil {
+		return fmt.Errorf("error resetting advanced settings for host '%s': %s", host.Name(), err)
+	}
+
+	return nil
+}
+
+// resourceVSphereHostAdvancedSettingsImport takes an id in the form
+// '<host id or hostname>:<key>,<key>'
+func resourceVSphereHostAdvancedSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idx := strings.LastIndex(d.Id(), ":")
+	if idx < 1 || idx == len(d.Id())-1 {
+		return nil, fmt.Errorf("invalid import id '%s', should be in the form '<host>:<key>,<key>'", d.Id())
+	}
+
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.CheckIfHostnameOrID(client, d.Id()[:idx])
+	if err != nil {
+		return nil, err
+	}
+
+	optManager, err := hostconfig.GetOptionManager(client, host)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := strings.Split(d.Id()[idx+1:], ",")
+	settings, err := vsphereHostAdvancedSettingsRead(optManager, keys)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving advanced settings for host '%s': %s", host.Name(), err)
+	}
+
+	for _, key := range keys {
+		if _, ok := settings[key]; !ok {
+			return nil, fmt.Errorf("advanced setting '%s' does not exist on host '%s'", key, host.Name())
+		}
+	}
+
+	d.SetId(hr.Value)
+	d.Set(hr.IDName, hr.Value)
+	d.Set("settings", settings)
+	return []*schema.ResourceData{d}, nil
+}
+
+// vsphereHostAdvancedSettingsRead reads back only the given keys from the host
+// option manager formatted as strings
+func vsphereHostAdvancedSettingsRead(optManager *object.OptionManager, keys []string) (map[string]interface{}, error) {
+	values, err := hostconfig.QueryOptionValues(optManager, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		if value, ok := values[key]; ok {
+			settings[key] = hostconfig.OptionValueString(value)
+		}
+	}
+
+	return settings, nil
+}
+
+// vsphereHostAdvancedSettingsUpdate only sends the settings that differ from
+// the host as some options restart services when they are updated
+func vsphereHostAdvancedSettingsUpdate(optManager *object.OptionManager, settings map[string]interface{}) error {
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+
+	current, err := hostconfig.QueryOptionValues(optManager, keys)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]string, len(settings))
+	for key, value := range settings {
+		if currentVal, ok := current[key]; ok && hostconfig.OptionValueString(currentVal) == value.(string) {
+			continue
+		}
+
+		values[key] = value.(string)
+	}
+
+	return hostconfig.UpdateOptionValues(optManager, current, values)
+}
+
+// vsphereHostAdvancedSettingsReset restores the given keys to the defaults the
+// host reports for them.  Keys without a default are left as is
+func vsphereHostAdvancedSettingsReset(optManager *object.OptionManager, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	defaults, err := hostconfig.QueryOptionDefaults(optManager, keys)
+	if err != nil {
+		return err
+	}
+
+	settings := make(map[string]interface{}, len(defaults))
+	for key, value := range defaults {
+		settings[key] = hostconfig.OptionValueString(value)
+	}
+
+	return vsphereHostAdvancedSettingsUpdate(optManager, settings)
+}
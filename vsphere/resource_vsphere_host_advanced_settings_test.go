@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+)
+
+const (
+	hostAdvancedSettingsResourceName = "vsphere_host_advanced_settings.settings"
+	hostAdvancedSettingsShellKey     = "UserVars.SuppressShellWarning"
+	hostAdvancedSettingsSaltingKey   = "Mem.ShareForceSalting"
+)
+
+func TestAccResourceVSphereHostAdvancedSettings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariablesF(t, []string{"TF_VAR_VSPHERE_ESXI1"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereHostAdvancedSettingsValidate(hostAdvancedSettingsShellKey, "0"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereHostAdvancedSettingsConfig(map[string]string{
+					hostAdvancedSettingsShellKey:   "1",
+					hostAdvancedSettingsSaltingKey: "0",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostAdvancedSettingsResourceName, "settings.%", "2"),
+					testAccResourceVSphereHostAdvancedSettingsValidate(hostAdvancedSettingsShellKey, "1"),
+					testAccResourceVSphereHostAdvancedSettingsValidate(hostAdvancedSettingsSaltingKey, "0"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereHostAdvancedSettingsConfig(map[string]string{
+					hostAdvancedSettingsShellKey: "1",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostAdvancedSettingsResourceName, "settings.%", "1"),
+					testAccResourceVSphereHostAdvancedSettingsValidate(hostAdvancedSettingsSaltingKey, "2"),
+				),
+			},
+			{
+				ResourceName:      hostAdvancedSettingsResourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:%s", os.Getenv("TF_VAR_VSPHERE_ESXI1"), hostAdvancedSettingsShellKey),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereHostAdvancedSettingsValidate(key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client).vimClient
+		host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+		if err != nil {
+			return err
+		}
+
+		optManager, err := hostconfig.GetOptionManager(client, host)
+		if err != nil {
+			return err
+		}
+
+		settings, err := vsphereHostAdvancedSettingsRead(optManager, []string{key})
+		if err != nil {
+			return err
+		}
+
+		if settings[key] != value {
+			return fmt.Errorf("setting '%s' should be '%s'; got '%v'", key, value, settings[key])
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereHostAdvancedSettingsConfig(settings map[string]string) string {
+	var values string
+	for key, value := range settings {
+		values += fmt.Sprintf("\t\t\t\"%s\" = \"%s\"\n", key, value)
+	}
+
+	return fmt.Sprintf(`
+	resource "vsphere_host_advanced_settings" "settings" {
+		hostname = "%s"
+
+		settings = {
+%s		}
+	}
+	`,
+		os.Getenv("TF_VAR_VSPHERE_ESXI1"),
+		values,
+	)
+}
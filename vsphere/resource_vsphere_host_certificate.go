@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+)
+
+func resourceVSphereHostCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceVSphereHostCertificateCreate,
+		Read:          resourceVSphereHostCertificateRead,
+		Update:        resourceVSphereHostCertificateUpdate,
+		Delete:        resourceVSphereHostCertificateDelete,
+		CustomizeDiff: resourceVSphereHostCertificateCustomDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereHostCertificateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host_system_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Host id of machine to manage the certificate of",
+				ExactlyOneOf: []string{"hostname"},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Hostname of machine to manage the certificate of",
+			},
+			"generate_csr": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Generate a certificate signing request on the host.  A new request is generated when csr_distinguished_name changes",
+			},
+			"csr_distinguished_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Distinguished name of the certificate signing request.  Defaults to the hostname as common name",
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM encoded certificate signing request generated on the host",
+			},
+			"certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "PEM encoded signed certificate to install on the host",
+				ValidateFunc: certificate.ValidatePEM(),
+			},
+			"ca_certificates": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "PEM encoded ca certificates that replace all ca certificates trusted by the host",
+				ConflictsWith: []string{"refresh_ca_certificates"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: certificate.ValidatePEM(),
+				},
+			},
+			"ca_crls": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Description:  "PEM encoded certificate revocation lists that replace all crls of the host",
+				RequiredWith: []string{"ca_certificates"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+			},
+			"refresh_ca_certificates": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Push the ca certificates and crls trusted by vcenter to the host whenever the certificate is installed",
+			},
+			"thumbprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-1 thumbprint of the host certificate",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the host certificate",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Issuer of the host certificate",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the host certificate such as 'good' or 'expiring'",
+			},
+			"valid_from": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the host certificate became valid in RFC3339 format",
+			},
+			"valid_to": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the host certificate expires in RFC3339 format",
+			},
+		},
+	}
+}
+
+func resourceVSphereHostCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] creating certificate for host '%s'", host.Name())
+
+	if err = vsphereHostCertificateUpdate(d, client, host); err != nil {
+		return err
+	}
+
+	d.SetId(hr.Value)
+	return resourceVSphereHostCertificateRead(d, meta)
+}
+
+func resourceVSphereHostCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] reading certificate for host '%s'", host.Name())
+
+	return vsphereHostCertificateRead(d, client, host)
+}
+
+func resourceVSphereHostCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] updating certificate for host '%s'", host.Name())
+
+	if err = vsphereHostCertificateUpdate(d, client, host); err != nil {
+		return err
+	}
+
+	return resourceVSphereHostCertificateRead(d, meta)
+}
+
+// resourceVSphereHostCertificateDelete only removes the resource from state as
+// a host always needs a certificate and removing the ca certificates could
+// break its connection to vcenter
+func resourceVSphereHostCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] removing host certificate '%s' from state", d.Id())
+	return nil
+}
+
+func resourceVSphereHostCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.CheckIfHostnameOrID(client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = vsphereHostCertificateRead(d, client, host); err != nil {
+		return nil, err
+	}
+
+	d.Set(hr.IDName, hr.Value)
+	d.Set("generate_csr", false)
+	d.Set("refresh_ca_certificates", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceVSphereHostCertificateCustomDiff sets the thumbprint of a new
+// certificate at plan time so that vsphere_host can reconnect with it in the
+// same apply
+func resourceVSphereHostCertificateCustomDiff(ctx context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+	if !rd.HasChange("certificate") {
+		return nil
+	}
+
+	if !rd.NewValueKnown("certificate") {
+		return rd.SetNewComputed("thumbprint")
+	}
+
+	certPEM := rd.Get("certificate").(string)
+	if certPEM == "" {
+		return nil
+	}
+
+	cert, err := certificate.Parse(certPEM)
+	if err != nil {
+		return err
+	}
+
+	return rd.SetNew("thumbprint", certificate.Thumbprint(cert))
+}
+
+func vsphereHostCertificateRead(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem) error {
+	info, err := hostconfig.GetHostCertificateInfo(client, host)
+	if err != nil {
+		return err
+	}
+
+	// The installed certificate can't be read back so compare thumbprints to
+	// find out if the host is still using the certificate in state
+	if certPEM, ok := d.GetOk("certificate"); ok {
+		cert, err := certificate.Parse(certPEM.(string))
+		if err != nil || certificate.Thumbprint(cert) != info.ThumbprintSHA1 {
+			d.Set("certificate", "")
+		}
+	}
+
+	if _, ok := d.GetOk("ca_certificates"); ok {
+		caCerts, caCrls, err := hostconfig.ListHostCACertificates(client, host)
+		if err != nil {
+			return err
+		}
+
+		d.Set("ca_certificates", flattenHostCACertificates(d.Get("ca_certificates").([]interface{}), caCerts))
+		d.Set("ca_crls", caCrls)
+	}
+
+	d.Set("thumbprint", info.ThumbprintSHA1)
+	d.Set("subject", info.Subject)
+	d.Set("issuer", info.Issuer)
+	d.Set("status", info.Status)
+
+	if info.NotBefore != nil {
+		d.Set("valid_from", info.NotBefore.UTC().Format(time.RFC3339))
+	}
+
+	if info.NotAfter != nil {
+		d.Set("valid_to", info.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// flattenHostCACertificates keeps the certificates from state that are still
+// trusted by the host so that differences in encoding don't show a diff
+func flattenHostCACertificates(current []interface{}, caCerts []string) []interface{} {
+	if len(current) == len(caCerts) {
+		same := true
+		for i, c := range current {
+			if !certificate.Equal(c.(string), caCerts[i]) {
+				same = false
+				break
+			}
+		}
+
+		if same {
+			return current
+		}
+	}
+
+	return structure.SliceStringsToInterfaces(caCerts)
+}
+
+// vsphereHostCertificateUpdate replaces the ca certificates first so that the
+// host trusts the ca of a new certificate before it is installed
+func vsphereHostCertificateUpdate(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem) error {
+	if d.HasChanges("ca_certificates", "ca_crls") {
+		if _, ok := d.GetOk("ca_certificates"); ok {
+			caCerts := structure.SliceInterfacesToStrings(d.Get("ca_certificates").([]interface{}))
+			caCrls := structure.SliceInterfacesToStrings(d.Get("ca_crls").([]interface{}))
+
+			if err := hostconfig.ReplaceHostCACertificates(client, host, caCerts, caCrls); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.Get("generate_csr").(bool) && d.HasChanges("generate_csr", "csr_distinguished_name") {
+		csr, err := hostconfig.GenerateHostCSR(client, host, d.Get("csr_distinguished_name").(string))
+		if err != nil {
+			return err
+		}
+
+		d.Set("csr", csr)
+	}
+
+	installed := false
+
+	if certPEM := d.Get("certificate").(string); certPEM != "" && d.HasChange("certificate") {
+		if err := hostconfig.InstallHostCertificate(client, host, certPEM); err != nil {
+			return err
+		}
+
+		installed = true
+	}
+
+	if d.Get("refresh_ca_certificates").(bool) && (installed || d.HasChange("refresh_ca_certificates")) {
+		if err := hostconfig.RefreshHostCACertificates(client, host); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
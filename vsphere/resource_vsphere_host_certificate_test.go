@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+)
+
+const (
+	hostCertificateResourceName = "vsphere_host_certificate.h1"
+)
+
+func TestAccResourceVSphereHostCertificate_basic(t *testing.T) {
+	// ca_certificates replaces the whole trust store of the host, so keep the
+	// ca certificates it already trusts in config and restore them afterwards.
+	// The step configs are built before the test case runs its PreCheck
+	caCerts, caCrls := testAccResourceVSphereHostCertificatePreCheck(t)
+	caPEM := testAccGenerateCACertificatePEM(t, "tf-acc-test-host-ca")
+	config := testAccResourceVSphereHostCertificateConfig(append(caCerts, caPEM), caCrls)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariablesF(t, []string{"TF_VAR_VSPHERE_ESXI1"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereHostCertificateRestoreCA(caCerts, caCrls, caPEM),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(hostCertificateResourceName, "csr", regexp.MustCompile("BEGIN CERTIFICATE REQUEST")),
+					resource.TestCheckResourceAttrSet(hostCertificateResourceName, "thumbprint"),
+					resource.TestCheckResourceAttrSet(hostCertificateResourceName, "valid_to"),
+					testAccResourceVSphereHostCertificateHasCA(hostCertificateResourceName, caPEM),
+				),
+			},
+			{
+				ResourceName: hostCertificateResourceName,
+				Config:       config,
+				ImportState:  true,
+			},
+		},
+	})
+}
+
+// testAccResourceVSphereHostCertificatePreCheck returns the ca certificates
+// and crls the host trusts before the test runs
+func testAccResourceVSphereHostCertificatePreCheck(t *testing.T) ([]string, []string) {
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("set TF_ACC to run vsphere_host_certificate acceptance tests (provider connection is required)")
+	}
+
+	testAccPreCheck(t)
+	testAccCheckEnvVariablesF(t, []string{"TF_VAR_VSPHERE_ESXI1"})
+
+	meta, err := testAccProviderMeta(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := meta.(*Client).vimClient
+	host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caCerts, caCrls, err := hostconfig.ListHostCACertificates(client, host)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return caCerts, caCrls
+}
+
+// testAccResourceVSphereHostCertificateRestoreCA restores the ca certificates
+// and crls the host trusted before the test, as deleting the resource leaves
+// them alone, and checks that the test ca certificate is gone
+func testAccResourceVSphereHostCertificateRestoreCA(caCerts, caCrls []string, testCAPEM string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client).vimClient
+		host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+		if err != nil {
+			return err
+		}
+
+		if err = hostconfig.ReplaceHostCACertificates(client, host, caCerts, caCrls); err != nil {
+			return err
+		}
+
+		current, _, err := hostconfig.ListHostCACertificates(client, host)
+		if err != nil {
+			return err
+		}
+
+		for _, caPEM := range caCerts {
+			if !certificate.Contains(current, caPEM) {
+				return fmt.Errorf("host '%s' no longer trusts one of its original ca certificates", host.Name())
+			}
+		}
+
+		if certificate.Contains(current, testCAPEM) {
+			return fmt.Errorf("host '%s' still trusts the test ca certificate", host.Name())
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereHostCertificateHasCA(name, caPEM string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("'%s' key not found on the server", name)
+		}
+
+		client := testAccProvider.Meta().(*Client).vimClient
+		host, err := hostsystem.FromHostname(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		caCerts, _, err := hostconfig.ListHostCACertificates(client, host)
+		if err != nil {
+			return err
+		}
+
+		if !certificate.Contains(caCerts, caPEM) {
+			return fmt.Errorf("expected host '%s' to trust the test ca certificate", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereHostCertificateConfig(caCerts, caCrls []string) string {
+	return fmt.Sprintf(`
+	resource "vsphere_host_certificate" "h1" {
+		hostname     = "%s"
+		generate_csr = true
+
+		ca_certificates = [%s]
+		ca_crls         = [%s]
+	}
+	`,
+		os.Getenv("TF_VAR_VSPHERE_ESXI1"),
+		testAccHostCertificateHeredocs(caCerts),
+		testAccHostCertificateHeredocs(caCrls),
+	)
+}
+
+func testAccHostCertificateHeredocs(pems []string) string {
+	docs := make([]string, 0, len(pems))
+	for _, p := range pems {
+		docs = append(docs, fmt.Sprintf("<<EOT\n%s\nEOT\n", strings.TrimSpace(p)))
+	}
+
+	return strings.Join(docs, ",")
+}
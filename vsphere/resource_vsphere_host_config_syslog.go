@@ -10,16 +10,18 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
 )
 
 func resourceVSphereHostConfigSyslog() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceVSphereHostConfigSyslogCreate,
-		Read:   resourceVSphereHostConfigSyslogRead,
-		Update: resourceVSphereHostConfigSyslogUpdate,
-		Delete: resourceVSphereHostConfigSyslogDelete,
+		Create:        resourceVSphereHostConfigSyslogCreate,
+		Read:          resourceVSphereHostConfigSyslogRead,
+		Update:        resourceVSphereHostConfigSyslogUpdate,
+		Delete:        resourceVSphereHostConfigSyslogDelete,
+		CustomizeDiff: resourceVSphereHostConfigSyslogCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceVSphereHostConfigSyslogImport,
 		},
@@ -53,6 +55,100 @@ func resourceVSphereHostConfigSyslog() *schema.Resource {
 					false,
 				),
 			},
+			"ca_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "PEM encoded ca certificate of the remote host to trust when forwarding logs over tls",
+				ValidateFunc: certificate.ValidatePEM(),
+			},
+			"ca_certificate_added": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether ca_certificate was added to the trusted ca certificates of the host by this resource.  Only then is it removed again",
+			},
+			"default_rotate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Default number of rotated log files to keep",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"default_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Default size in KiB of log files before they are rotated",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"log_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Datastore path of the directory to output logs to such as '[datastore1] /logs'",
+			},
+			"log_dir_unique": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Place logs in a subdirectory of log_dir named after the host",
+			},
+			"audit_record_storage_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Enable local storage of audit records",
+			},
+			"audit_record_storage_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Capacity in MiB of the local audit record storage",
+				ValidateFunc: validation.IntAtLeast(4),
+			},
+			"audit_record_storage_directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Datastore path of the directory to store audit records in",
+			},
+			"audit_record_remote_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Forward audit records to the remote log host",
+			},
+			"configured_attributes": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Syslog attributes set in config.  Only these are reset to their defaults when removed from config or when the resource is deleted",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"logger": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Rotation settings of individual loggers.  Loggers removed from config are reset to their defaults",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the logger such as 'vmkernel' or 'hostd'",
+						},
+						"rotate": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Number of rotated log files to keep for the logger",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Size in KiB of the log file of the logger before it is rotated",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -66,7 +162,7 @@ func resourceVSphereHostConfigSyslogRead(d *schema.ResourceData, meta interface{
 
 	log.Printf("[INFO] reading syslog settings for host '%s'", host.Name())
 
-	if err = hostconfig.HostConfigSyslogRead(d, client, host); err != nil {
+	if err = hostconfig.HostConfigSyslogRead(d, client, host, hostconfig.SyslogLoggersDeclared); err != nil {
 		return err
 	}
 
@@ -130,7 +226,7 @@ func resourceVSphereHostConfigSyslogImport(ctx context.Context, d *schema.Resour
 		return nil, err
 	}
 
-	if err = hostconfig.HostConfigSyslogRead(d, meta.(*Client).vimClient, host); err != nil {
+	if err = hostconfig.HostConfigSyslogRead(d, meta.(*Client).vimClient, host, hostconfig.SyslogLoggersChanged); err != nil {
 		return nil, err
 	}
 
@@ -138,3 +234,27 @@ func resourceVSphereHostConfigSyslogImport(ctx context.Context, d *schema.Resour
 	d.Set(hr.IDName, hr.Value)
 	return []*schema.ResourceData{d}, nil
 }
+
+// resourceVSphereHostConfigSyslogCustomizeDiff records which of the computed
+// syslog attributes are set in config, as the config isn't available on delete
+// and the attributes read back from the host can't be told apart otherwise
+func resourceVSphereHostConfigSyslogCustomizeDiff(ctx context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+	config := rd.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	configured := make([]interface{}, 0)
+	for attr := range hostconfig.SyslogOptionAttributes {
+		if !config.GetAttr(attr).IsNull() {
+			configured = append(configured, attr)
+		}
+	}
+
+	current := rd.Get("configured_attributes").(*schema.Set)
+	if current.Equal(schema.NewSet(schema.HashString, configured)) {
+		return nil
+	}
+
+	return rd.SetNew("configured_attributes", configured)
+}
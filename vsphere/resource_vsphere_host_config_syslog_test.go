@@ -83,6 +83,37 @@ func TestAccResourceVSphereHostConfigSyslog_UsingHostname(t *testing.T) {
 	})
 }
 
+func TestAccResourceVSphereHostConfigSyslog_extendedOptions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariablesF(t, []string{"TF_VAR_VSPHERE_ESXI1"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereHostConfigSyslogDestroy(hostConfigSyslogResourceName, true),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereHostConfigSyslogExtendedConfig(10, 2048),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostConfigSyslogResourceName, "default_rotate", "10"),
+					resource.TestCheckResourceAttr(hostConfigSyslogResourceName, "log_dir_unique", "true"),
+					resource.TestCheckResourceAttr(hostConfigSyslogResourceName, "logger.#", "1"),
+					resource.TestCheckResourceAttr(hostConfigSyslogResourceName, "configured_attributes.#", "3"),
+					testAccResourceVSphereHostConfigSyslogKey(hostConfigSyslogResourceName, hostconfig.SyslogDefaultRotateKey, int32(10)),
+				),
+			},
+			{
+				Config: testAccResourceVSphereHostConfigSyslogExtendedConfig(12, 4096),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostConfigSyslogResourceName, "default_rotate", "12"),
+					testAccResourceVSphereHostConfigSyslogKey(hostConfigSyslogResourceName, hostconfig.SyslogDefaultRotateKey, int32(12)),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourceVSphereHostConfigSyslogDestroy(name string, useHostname bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -157,6 +188,53 @@ func testAccResourceVSphereHostConfigSyslogConfig(logLvl string, useHostname boo
 	)
 }
 
+func testAccResourceVSphereHostConfigSyslogExtendedConfig(rotate, size int) string {
+	return fmt.Sprintf(
+		`
+		resource "vsphere_host_config_syslog" "h1" {
+			hostname = "%s"
+			default_rotate = %d
+			default_size = %d
+			log_dir_unique = true
+
+			logger {
+				name = "vmkernel"
+				rotate = %d
+				size = %d
+			}
+		}
+		`,
+		os.Getenv("TF_VAR_VSPHERE_ESXI1"),
+		rotate,
+		size,
+		rotate,
+		size,
+	)
+}
+
+func testAccResourceVSphereHostConfigSyslogKey(resourceName, key string, expectedVal interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("%s key not found on the server", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*Client).vimClient
+		host, err := hostsystem.FromHostname(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		optManager, err := hostconfig.GetOptionManager(client, host)
+		if err != nil {
+			return err
+		}
+
+		return testHostConfigSyslogKey(context.Background(), optManager, key, rs.Primary.ID, expectedVal)
+	}
+}
+
 func testAccResourceVSphereHostConfigSyslogValidate(resourceName, logLvl string, useHostname bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
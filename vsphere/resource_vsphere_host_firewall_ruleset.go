@@ -0,0 +1,454 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func resourceVSphereHostFirewallRuleset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereHostFirewallRulesetCreate,
+		Read:   resourceVSphereHostFirewallRulesetRead,
+		Update: resourceVSphereHostFirewallRulesetUpdate,
+		Delete: resourceVSphereHostFirewallRulesetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereHostFirewallRulesetImport,
+		},
+		CustomizeDiff: resourceVSphereHostFirewallRulesetCustomDiff,
+
+		Schema: map[string]*schema.Schema{
+			"host_system_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Host id of machine to update firewall rulesets on",
+				ExactlyOneOf: []string{"hostname"},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Hostname of machine to update firewall rulesets on",
+			},
+			"ruleset": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Firewall rulesets to manage.  Rulesets removed from config are restored to the state they were in before they were managed",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key of the ruleset such as 'sshServer', 'syslog' or 'ntpClient'",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the ports of the ruleset are open",
+						},
+						"allowed_hosts": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "IP addresses and networks in CIDR notation the ruleset is restricted to.  All addresses are allowed when empty",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.Any(
+									validation.IsIPAddress,
+									validation.IsCIDR,
+								),
+							},
+						},
+					},
+				},
+			},
+			"original_ruleset": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "State of the managed rulesets before they were first managed, which is restored when they are removed from config or the resource is deleted",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key of the ruleset",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the ports of the ruleset were open",
+						},
+						"allowed_hosts": {
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Description: "IP addresses and networks the ruleset was restricted to",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"incoming_blocked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether incoming traffic not matched by an enabled ruleset is blocked",
+			},
+			"outgoing_blocked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether outgoing traffic not matched by an enabled ruleset is blocked",
+			},
+		},
+	}
+}
+
+func resourceVSphereHostFirewallRulesetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] reading firewall rulesets for host '%s'", host.Name())
+
+	info, err := hostconfig.GetHostFirewallInfo(client, host)
+	if err != nil {
+		return err
+	}
+
+	current := vsphereHostFirewallRulesetMap(info)
+	rulesets := make([]interface{}, 0)
+
+	for _, v := range d.Get("ruleset").(*schema.Set).List() {
+		key := v.(map[string]interface{})["key"].(string)
+
+		ruleset, ok := current[key]
+		if !ok {
+			log.Printf("[DEBUG] firewall ruleset '%s' not found on host '%s'", key, host.Name())
+			continue
+		}
+
+		rulesets = append(rulesets, flattenHostFirewallRuleset(ruleset))
+	}
+
+	d.Set("ruleset", rulesets)
+	d.Set("incoming_blocked", structure.BoolNilFalse(info.DefaultPolicy.IncomingBlocked))
+	d.Set("outgoing_blocked", structure.BoolNilFalse(info.DefaultPolicy.OutgoingBlocked))
+
+	return nil
+}
+
+func resourceVSphereHostFirewallRulesetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] creating firewall rulesets for host '%s'", host.Name())
+
+	if err = vsphereHostFirewallRulesetUpdate(d, client, host, nil); err != nil {
+		return err
+	}
+
+	d.SetId(hr.Value)
+
+	return resourceVSphereHostFirewallRulesetRead(d, meta)
+}
+
+func resourceVSphereHostFirewallRulesetUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] updating firewall rulesets for host '%s'", host.Name())
+
+	oldVal, newVal := d.GetChange("ruleset")
+	newKeys := make(map[string]bool)
+	for _, v := range newVal.(*schema.Set).List() {
+		newKeys[v.(map[string]interface{})["key"].(string)] = true
+	}
+
+	removed := make([]string, 0)
+	for _, v := range oldVal.(*schema.Set).List() {
+		key := v.(map[string]interface{})["key"].(string)
+		if !newKeys[key] {
+			removed = append(removed, key)
+		}
+	}
+
+	if err = vsphereHostFirewallRulesetUpdate(d, client, host, removed); err != nil {
+		return err
+	}
+
+	return resourceVSphereHostFirewallRulesetRead(d, meta)
+}
+
+// resourceVSphereHostFirewallRulesetDelete restores the managed rulesets to the
+// state they were in before they were managed.  The default policy is left as
+// is
+func resourceVSphereHostFirewallRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] deleting firewall rulesets for host '%s'", host.Name())
+
+	info, err := hostconfig.GetHostFirewallInfo(client, host)
+	if err != nil {
+		return err
+	}
+
+	current := vsphereHostFirewallRulesetMap(info)
+	original := expandHostFirewallOriginalRulesets(d)
+	for _, v := range d.Get("ruleset").(*schema.Set).List() {
+		key := v.(map[string]interface{})["key"].(string)
+		if err = vsphereHostFirewallRulesetRestore(client, host, current, key, original[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceVSphereHostFirewallRulesetImport imports all rulesets that are
+// enabled on the host and are not required by it
+func resourceVSphereHostFirewallRulesetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.CheckIfHostnameOrID(client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := hostconfig.GetHostFirewallInfo(client, host)
+	if err != nil {
+		return nil, err
+	}
+
+	rulesets := make([]interface{}, 0)
+	for _, ruleset := range info.Ruleset {
+		if ruleset.Enabled && !ruleset.Required {
+			rulesets = append(rulesets, flattenHostFirewallRuleset(ruleset))
+		}
+	}
+
+	d.SetId(d.Id())
+	d.Set(hr.IDName, hr.Value)
+	d.Set("ruleset", rulesets)
+	d.Set("original_ruleset", rulesets)
+	d.Set("incoming_blocked", structure.BoolNilFalse(info.DefaultPolicy.IncomingBlocked))
+	d.Set("outgoing_blocked", structure.BoolNilFalse(info.DefaultPolicy.OutgoingBlocked))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVSphereHostFirewallRulesetCustomDiff(ctx context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+	keys := make(map[string]bool)
+
+	for _, v := range rd.Get("ruleset").(*schema.Set).List() {
+		key := v.(map[string]interface{})["key"].(string)
+		if keys[key] {
+			return fmt.Errorf("duplicate values for 'key' attribute in 'ruleset' block is not allowed")
+		}
+		keys[key] = true
+	}
+
+	// The original state of newly managed rulesets is only known once they
+	// are applied
+	if rd.Id() != "" && rd.HasChange("ruleset") {
+		if err := rd.SetNewComputed("original_ruleset"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// vsphereHostFirewallRulesetUpdate restores the removed rulesets, records the
+// original state of newly managed rulesets, applies the configured rulesets and
+// updates the default policy when it is configured.  Only the settings that
+// differ from the host are sent
+func vsphereHostFirewallRulesetUpdate(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem, removed []string) error {
+	info, err := hostconfig.GetHostFirewallInfo(client, host)
+	if err != nil {
+		return err
+	}
+
+	current := vsphereHostFirewallRulesetMap(info)
+	original := expandHostFirewallOriginalRulesets(d)
+
+	for _, key := range removed {
+		if err = vsphereHostFirewallRulesetRestore(client, host, current, key, original[key]); err != nil {
+			return err
+		}
+		delete(original, key)
+	}
+
+	for _, v := range d.Get("ruleset").(*schema.Set).List() {
+		ruleset := v.(map[string]interface{})
+		key := ruleset["key"].(string)
+		allowedHosts := structure.SliceInterfacesToStrings(ruleset["allowed_hosts"].(*schema.Set).List())
+
+		if _, ok := original[key]; !ok {
+			if r, ok := current[key]; ok {
+				original[key] = flattenHostFirewallRuleset(r)
+			}
+		}
+
+		if err = vsphereHostFirewallRulesetApply(client, host, current, key, ruleset["enabled"].(bool), allowedHosts); err != nil {
+			d.Set("original_ruleset", flattenHostFirewallOriginalRulesets(original))
+			return err
+		}
+	}
+
+	d.Set("original_ruleset", flattenHostFirewallOriginalRulesets(original))
+
+	incoming := d.GetRawConfig().GetAttr("incoming_blocked")
+	outgoing := d.GetRawConfig().GetAttr("outgoing_blocked")
+	if incoming.IsNull() && outgoing.IsNull() {
+		return nil
+	}
+
+	incomingBlocked := structure.BoolNilFalse(info.DefaultPolicy.IncomingBlocked)
+	if !incoming.IsNull() {
+		incomingBlocked = incoming.True()
+	}
+
+	outgoingBlocked := structure.BoolNilFalse(info.DefaultPolicy.OutgoingBlocked)
+	if !outgoing.IsNull() {
+		outgoingBlocked = outgoing.True()
+	}
+
+	if incomingBlocked == structure.BoolNilFalse(info.DefaultPolicy.IncomingBlocked) &&
+		outgoingBlocked == structure.BoolNilFalse(info.DefaultPolicy.OutgoingBlocked) {
+		return nil
+	}
+
+	return hostconfig.UpdateHostFirewallDefaultPolicy(client, host, incomingBlocked, outgoingBlocked)
+}
+
+// vsphereHostFirewallRulesetApply sets the allowed hosts before enabling the
+// ruleset so that its ports are never open to more addresses than configured
+func vsphereHostFirewallRulesetApply(client *govmomi.Client, host *object.HostSystem, current map[string]types.HostFirewallRuleset, key string, enabled bool, allowedHosts []string) error {
+	ruleset, ok := current[key]
+	if !ok {
+		return fmt.Errorf("firewall ruleset '%s' does not exist on host '%s'", key, host.Name())
+	}
+
+	if allowedHosts == nil {
+		allowedHosts = make([]string, 0)
+	}
+
+	sort.Strings(allowedHosts)
+	if !reflect.DeepEqual(hostconfig.FirewallIPListToStrings(ruleset.AllowedHosts), allowedHosts) {
+		if err := hostconfig.UpdateHostFirewallRulesetAllowedHosts(client, host, key, allowedHosts); err != nil {
+			return err
+		}
+	}
+
+	if ruleset.Enabled != enabled {
+		if err := hostconfig.SetHostFirewallRulesetEnabled(client, host, key, enabled); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// vsphereHostFirewallRulesetRestore puts the ruleset back in the state it was
+// in before it was managed.  Without a recorded state the ruleset is left
+// enabled or disabled as is and only allows all ip addresses again
+func vsphereHostFirewallRulesetRestore(client *govmomi.Client, host *object.HostSystem, current map[string]types.HostFirewallRuleset, key string, original map[string]interface{}) error {
+	ruleset, ok := current[key]
+	if !ok {
+		log.Printf("[DEBUG] firewall ruleset '%s' not found on host '%s', skipping restore", key, host.Name())
+		return nil
+	}
+
+	enabled := ruleset.Enabled
+	allowedHosts := make([]string, 0)
+
+	if original != nil {
+		enabled = original["enabled"].(bool)
+		allowedHosts = structure.SliceInterfacesToStrings(original["allowed_hosts"].([]interface{}))
+	}
+
+	// Rulesets the host requires can't be disabled
+	if ruleset.Required {
+		enabled = true
+	}
+
+	// Close the ports before allowing more addresses on them
+	if ruleset.Enabled && !enabled {
+		if err := hostconfig.SetHostFirewallRulesetEnabled(client, host, key, false); err != nil {
+			return err
+		}
+		ruleset.Enabled = false
+		current[key] = ruleset
+	}
+
+	return vsphereHostFirewallRulesetApply(client, host, current, key, enabled, allowedHosts)
+}
+
+// expandHostFirewallOriginalRulesets returns the recorded original state of
+// the managed rulesets keyed by ruleset key
+func expandHostFirewallOriginalRulesets(d *schema.ResourceData) map[string]map[string]interface{} {
+	original := make(map[string]map[string]interface{})
+
+	for _, v := range d.Get("original_ruleset").(*schema.Set).List() {
+		ruleset := v.(map[string]interface{})
+		original[ruleset["key"].(string)] = map[string]interface{}{
+			"key":           ruleset["key"],
+			"enabled":       ruleset["enabled"],
+			"allowed_hosts": ruleset["allowed_hosts"].(*schema.Set).List(),
+		}
+	}
+
+	return original
+}
+
+func flattenHostFirewallOriginalRulesets(original map[string]map[string]interface{}) []interface{} {
+	rulesets := make([]interface{}, 0, len(original))
+	for _, ruleset := range original {
+		rulesets = append(rulesets, ruleset)
+	}
+
+	return rulesets
+}
+
+func vsphereHostFirewallRulesetMap(info *types.HostFirewallInfo) map[string]types.HostFirewallRuleset {
+	rulesets := make(map[string]types.HostFirewallRuleset, len(info.Ruleset))
+	for _, ruleset := range info.Ruleset {
+		rulesets[ruleset.Key] = ruleset
+	}
+
+	return rulesets
+}
+
+func flattenHostFirewallRuleset(ruleset types.HostFirewallRuleset) map[string]interface{} {
+	return map[string]interface{}{
+		"key":           ruleset.Key,
+		"enabled":       ruleset.Enabled,
+		"allowed_hosts": structure.SliceStringsToInterfaces(hostconfig.FirewallIPListToStrings(ruleset.AllowedHosts)),
+	}
+}
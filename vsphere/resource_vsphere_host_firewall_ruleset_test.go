@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	hostFirewallRulesetResourceName = "vsphere_host_firewall_ruleset.h1"
+	hostFirewallRulesetKey          = "syslog"
+)
+
+func TestAccResourceVSphereHostFirewallRuleset_basic(t *testing.T) {
+	var original types.HostFirewallRuleset
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariablesF(t, []string{"TF_VAR_VSPHERE_ESXI1"})
+			original = testAccResourceVSphereHostFirewallRulesetOriginal(t, hostFirewallRulesetKey)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccResourceVSphereHostFirewallRulesetValidate(
+				hostFirewallRulesetKey,
+				original.Enabled,
+				hostconfig.FirewallIPListToStrings(original.AllowedHosts),
+			)(s)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereHostFirewallRulesetConfig(`"192.0.2.10", "10.0.0.0/8"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostFirewallRulesetResourceName, "ruleset.#", "1"),
+					resource.TestCheckResourceAttr(hostFirewallRulesetResourceName, "original_ruleset.#", "1"),
+					testAccResourceVSphereHostFirewallRulesetValidate(hostFirewallRulesetKey, true, []string{"10.0.0.0/8", "192.0.2.10"}),
+				),
+			},
+			{
+				Config: testAccResourceVSphereHostFirewallRulesetConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceVSphereHostFirewallRulesetValidate(hostFirewallRulesetKey, true, []string{}),
+				),
+			},
+			{
+				ResourceName: hostFirewallRulesetResourceName,
+				Config:       testAccResourceVSphereHostFirewallRulesetConfig(""),
+				ImportState:  true,
+			},
+		},
+	})
+}
+
+// testAccResourceVSphereHostFirewallRulesetOriginal returns the ruleset as it
+// is before the test so that the destroy check can compare against it
+func testAccResourceVSphereHostFirewallRulesetOriginal(t *testing.T, key string) types.HostFirewallRuleset {
+	meta, err := testAccProviderMeta(t)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := meta.(*Client).vimClient
+	host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := hostconfig.GetHostFirewallInfo(client, host)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ruleset, ok := vsphereHostFirewallRulesetMap(info)[key]
+	if !ok {
+		t.Fatalf("firewall ruleset '%s' not found", key)
+	}
+
+	return ruleset
+}
+
+func testAccResourceVSphereHostFirewallRulesetValidate(key string, enabled bool, allowedHosts []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client).vimClient
+		host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+		if err != nil {
+			return err
+		}
+
+		info, err := hostconfig.GetHostFirewallInfo(client, host)
+		if err != nil {
+			return err
+		}
+
+		ruleset, ok := vsphereHostFirewallRulesetMap(info)[key]
+		if !ok {
+			return fmt.Errorf("firewall ruleset '%s' not found", key)
+		}
+
+		if ruleset.Enabled != enabled {
+			return fmt.Errorf("firewall ruleset '%s' enabled should be %t; got %t", key, enabled, ruleset.Enabled)
+		}
+
+		actual := hostconfig.FirewallIPListToStrings(ruleset.AllowedHosts)
+		if !reflect.DeepEqual(actual, allowedHosts) {
+			return fmt.Errorf("firewall ruleset '%s' allowed hosts should be %v; got %v", key, allowedHosts, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereHostFirewallRulesetConfig(allowedHosts string) string {
+	return fmt.Sprintf(`
+	resource "vsphere_host_firewall_ruleset" "h1" {
+		hostname = "%s"
+
+		ruleset {
+			key           = "%s"
+			allowed_hosts = [%s]
+		}
+	}
+	`,
+		os.Getenv("TF_VAR_VSPHERE_ESXI1"),
+		hostFirewallRulesetKey,
+		allowedHosts,
+	)
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+)
+
+func resourceVSphereHostKernelModule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereHostKernelModuleCreate,
+		Read:   resourceVSphereHostKernelModuleRead,
+		Update: resourceVSphereHostKernelModuleUpdate,
+		Delete: resourceVSphereHostKernelModuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereHostKernelModuleImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host_system_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Host id of machine to set kernel module options on",
+				ExactlyOneOf: []string{"hostname"},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Hostname of machine to set kernel module options on",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the kernel module such as 'nmlx5_core' or 'lpfc'",
+			},
+			"options": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Option string of the kernel module such as 'lpfc_lun_queue_depth=128'",
+			},
+			"loaded_options": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Option string the kernel module is currently loaded with",
+			},
+			"reboot_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the host must be rebooted for the options to take effect",
+			},
+		},
+	}
+}
+
+func resourceVSphereHostKernelModuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] reading options of kernel module '%s' for host '%s'", d.Get("name").(string), host.Name())
+
+	return vsphereHostKernelModuleRead(d, client, host)
+}
+
+func resourceVSphereHostKernelModuleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	log.Printf("[INFO] setting options of kernel module '%s' for host '%s'", name, host.Name())
+
+	if err = hostconfig.UpdateHostKernelModuleOptions(client, host, name, d.Get("options").(string)); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", hr.Value, name))
+
+	return vsphereHostKernelModuleRead(d, client, host)
+}
+
+func resourceVSphereHostKernelModuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	log.Printf("[INFO] updating options of kernel module '%s' for host '%s'", name, host.Name())
+
+	if err = hostconfig.UpdateHostKernelModuleOptions(client, host, name, d.Get("options").(string)); err != nil {
+		return err
+	}
+
+	return vsphereHostKernelModuleRead(d, client, host)
+}
+
+// resourceVSphereHostKernelModuleDelete clears the options so that the kernel
+// module uses its defaults once it is reloaded
+func resourceVSphereHostKernelModuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	log.Printf("[INFO] clearing options of kernel module '%s' for host '%s'", name, host.Name())
+
+	return hostconfig.UpdateHostKernelModuleOptions(client, host, name, "")
+}
+
+// resourceVSphereHostKernelModuleImport takes an id in the form
+// '<host id or hostname>:<module name>'
+func resourceVSphereHostKernelModuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).vimClient
+
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import ID '%s', expected 'host:module'", d.Id())
+	}
+
+	host, hr, err := hostsystem.CheckIfHostnameOrID(client, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("name", parts[1])
+
+	if err = vsphereHostKernelModuleRead(d, client, host); err != nil {
+		return nil, err
+	}
+
+	d.Set(hr.IDName, hr.Value)
+	d.SetId(fmt.Sprintf("%s:%s", hr.Value, parts[1]))
+	return []*schema.ResourceData{d}, nil
+}
+
+// vsphereHostKernelModuleRead reads the configured options of the module and
+// flags a pending reboot when the module is loaded with different options
+func vsphereHostKernelModuleRead(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem) error {
+	name := d.Get("name").(string)
+
+	options, err := hostconfig.GetHostKernelModuleOptions(client, host, name)
+	if err != nil {
+		return err
+	}
+
+	module, err := hostconfig.GetHostKernelModule(client, host, name)
+	if err != nil {
+		return err
+	}
+
+	loadedOptions := ""
+	rebootRequired := false
+	if module != nil {
+		loadedOptions = module.OptionString
+		rebootRequired = strings.TrimSpace(loadedOptions) != strings.TrimSpace(options)
+	}
+
+	d.Set("options", options)
+	d.Set("loaded_options", loadedOptions)
+	d.Set("reboot_required", rebootRequired)
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+)
+
+const hostKernelModuleResourceName = "vsphere_host_kernel_module.h1"
+
+func TestAccResourceVSphereHostKernelModule_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariables(t, []string{"TF_VAR_VSPHERE_ESXI1", "TF_VAR_VSPHERE_KERNEL_MODULE", "TF_VAR_VSPHERE_KERNEL_MODULE_OPTIONS"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereHostKernelModuleValidate(""),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereHostKernelModuleConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostKernelModuleResourceName, "options", os.Getenv("TF_VAR_VSPHERE_KERNEL_MODULE_OPTIONS")),
+					resource.TestCheckResourceAttrSet(hostKernelModuleResourceName, "reboot_required"),
+					testAccResourceVSphereHostKernelModuleValidate(os.Getenv("TF_VAR_VSPHERE_KERNEL_MODULE_OPTIONS")),
+				),
+			},
+			{
+				ResourceName:      hostKernelModuleResourceName,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s:%s", os.Getenv("TF_VAR_VSPHERE_ESXI1"), os.Getenv("TF_VAR_VSPHERE_KERNEL_MODULE")),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereHostKernelModuleValidate(options string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client).vimClient
+		host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+		if err != nil {
+			return err
+		}
+
+		name := os.Getenv("TF_VAR_VSPHERE_KERNEL_MODULE")
+		current, err := hostconfig.GetHostKernelModuleOptions(client, host, name)
+		if err != nil {
+			return err
+		}
+
+		if current != options {
+			return fmt.Errorf("options of kernel module '%s' should be '%s'; got '%s'", name, options, current)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereHostKernelModuleConfig() string {
+	return fmt.Sprintf(`
+	resource "vsphere_host_kernel_module" "h1" {
+		hostname = "%s"
+		name     = "%s"
+		options  = "%s"
+	}
+	`,
+		os.Getenv("TF_VAR_VSPHERE_ESXI1"),
+		os.Getenv("TF_VAR_VSPHERE_KERNEL_MODULE"),
+		os.Getenv("TF_VAR_VSPHERE_KERNEL_MODULE_OPTIONS"),
+	)
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func resourceVSphereHostLocalUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereHostLocalUserCreate,
+		Read:   resourceVSphereHostLocalUserRead,
+		Update: resourceVSphereHostLocalUserUpdate,
+		Delete: resourceVSphereHostLocalUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereHostLocalUserImport,
+		},
+		CustomizeDiff: resourceVSphereHostLocalUserCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"host_system_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Host id of machine to create the user on",
+				ExactlyOneOf: []string{"hostname"},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Hostname of machine to create the user on",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Login name of the user",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the user.  The password is only written and changes made outside of terraform are not detected",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the user",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the host role given to the user on the host such as 'Admin' or 'ReadOnly'",
+			},
+			"host_username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Username of a host administrator used to manage the role of the user when connected through vcenter",
+				RequiredWith: []string{"host_password"},
+			},
+			"host_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Description:  "Password of the host administrator used to manage the role of the user when connected through vcenter",
+				RequiredWith: []string{"host_username"},
+			},
+		},
+	}
+}
+
+func resourceVSphereHostLocalUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	username := d.Get("username").(string)
+
+	log.Printf("[INFO] creating user '%s' on host '%s'", username, host.Name())
+
+	if err = hostconfig.CreateHostLocalUser(client, host, &types.HostAccountSpec{
+		Id:          username,
+		Password:    d.Get("password").(string),
+		Description: d.Get("description").(string),
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", hr.Value, username))
+
+	if role, ok := d.GetOk("role"); ok {
+		if err = vsphereHostLocalUserWithHostSession(d, client, host, func(hostClient *govmomi.Client) error {
+			return hostconfig.SetHostPermissionRole(hostClient, username, role.(string))
+		}); err != nil {
+			return err
+		}
+	}
+
+	return resourceVSphereHostLocalUserRead(d, meta)
+}
+
+func resourceVSphereHostLocalUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	found, err := vsphereHostLocalUserRead(d, client, host)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		log.Printf("[DEBUG] user '%s' not found on host '%s', removing from state", d.Get("username").(string), host.Name())
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceVSphereHostLocalUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	username := d.Get("username").(string)
+
+	log.Printf("[INFO] updating user '%s' on host '%s'", username, host.Name())
+
+	if d.HasChanges("password", "description") {
+		spec := &types.HostAccountSpec{
+			Id:          username,
+			Description: d.Get("description").(string),
+		}
+
+		if d.HasChange("password") {
+			spec.Password = d.Get("password").(string)
+		}
+
+		if err = hostconfig.UpdateHostLocalUser(client, host, spec); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("role") {
+		if err = vsphereHostLocalUserWithHostSession(d, client, host, func(hostClient *govmomi.Client) error {
+			if role := d.Get("role").(string); role != "" {
+				return hostconfig.SetHostPermissionRole(hostClient, username, role)
+			}
+
+			return hostconfig.RemoveHostPermission(hostClient, username)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return resourceVSphereHostLocalUserRead(d, meta)
+}
+
+func resourceVSphereHostLocalUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	username := d.Get("username").(string)
+
+	log.Printf("[INFO] removing user '%s' from host '%s'", username, host.Name())
+
+	if d.Get("role").(string) != "" {
+		if err = vsphereHostLocalUserWithHostSession(d, client, host, func(hostClient *govmomi.Client) error {
+			return hostconfig.RemoveHostPermission(hostClient, username)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return hostconfig.RemoveHostLocalUser(client, host, username)
+}
+
+func resourceVSphereHostLocalUserImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).vimClient
+
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import ID '%s', expected 'host:username'", d.Id())
+	}
+
+	host, hr, err := hostsystem.CheckIfHostnameOrID(client, parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("username", parts[1])
+
+	found, err := vsphereHostLocalUserRead(d, client, host)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("user '%s' not found on host '%s'", parts[1], host.Name())
+	}
+
+	d.Set(hr.IDName, hr.Value)
+	d.SetId(fmt.Sprintf("%s:%s", hr.Value, parts[1]))
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVSphereHostLocalUserCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("role").(string) == "" || !meta.(*Client).vimClient.IsVC() {
+		return nil
+	}
+
+	if d.Get("host_username").(string) == "" {
+		return fmt.Errorf("host_username and host_password are required to manage the role of the user when connected through vcenter")
+	}
+
+	return nil
+}
+
+// vsphereHostLocalUserRead reads the user from the host and returns whether it
+// exists.  The role is only read when a session to the host is available
+func vsphereHostLocalUserRead(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem) (bool, error) {
+	username := d.Get("username").(string)
+
+	user, err := hostconfig.GetHostLocalUser(client, host, username)
+	if err != nil {
+		return false, err
+	}
+
+	if user == nil {
+		return false, nil
+	}
+
+	d.Set("description", user.FullName)
+
+	if client.IsVC() && d.Get("host_username").(string) == "" {
+		return true, nil
+	}
+
+	if err = vsphereHostLocalUserWithHostSession(d, client, host, func(hostClient *govmomi.Client) error {
+		role, err := hostconfig.GetHostPermissionRole(hostClient, username)
+		if err != nil {
+			return err
+		}
+
+		d.Set("role", role)
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// vsphereHostLocalUserWithHostSession runs f with a client connected to the
+// host itself, logging in with the host credentials when connected through
+// vcenter
+func vsphereHostLocalUserWithHostSession(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem, f func(*govmomi.Client) error) error {
+	hostClient, logout, err := hostconfig.NewHostSession(
+		client,
+		host,
+		d.Get("host_username").(string),
+		d.Get("host_password").(string),
+	)
+	if err != nil {
+		return err
+	}
+	defer logout()
+
+	return f(hostClient)
+}
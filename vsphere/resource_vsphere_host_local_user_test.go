@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+)
+
+const (
+	hostLocalUserResourceName = "vsphere_host_local_user.u1"
+	hostLocalUserUsername     = "tf-acc-test-user"
+)
+
+func TestAccResourceVSphereHostLocalUser_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariablesF(t, []string{"TF_VAR_VSPHERE_ESXI1", "TF_VAR_vsphere_esxi_ssh_user", "TF_VAR_vsphere_esxi_ssh_password"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereHostLocalUserRemoved,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereHostLocalUserConfig("VMw4re!tf-1", "first", "ReadOnly"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostLocalUserResourceName, "description", "first"),
+					resource.TestCheckResourceAttr(hostLocalUserResourceName, "role", "ReadOnly"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereHostLocalUserConfig("VMw4re!tf-2", "second", "Admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostLocalUserResourceName, "description", "second"),
+					resource.TestCheckResourceAttr(hostLocalUserResourceName, "role", "Admin"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereHostLocalUserConfig("VMw4re!tf-2", "second", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostLocalUserResourceName, "role", ""),
+				),
+			},
+			{
+				ResourceName:            hostLocalUserResourceName,
+				ImportStateId:           fmt.Sprintf("%s:%s", os.Getenv("TF_VAR_VSPHERE_ESXI1"), hostLocalUserUsername),
+				Config:                  testAccResourceVSphereHostLocalUserConfig("VMw4re!tf-2", "second", ""),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "host_username", "host_password"},
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereHostLocalUserRemoved(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client).vimClient
+	host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+	if err != nil {
+		return err
+	}
+
+	user, err := hostconfig.GetHostLocalUser(client, host, hostLocalUserUsername)
+	if err != nil {
+		return err
+	}
+
+	if user != nil {
+		return fmt.Errorf("user '%s' still exists on host '%s'", hostLocalUserUsername, host.Name())
+	}
+
+	return nil
+}
+
+func testAccResourceVSphereHostLocalUserConfig(password, description, role string) string {
+	return fmt.Sprintf(`
+	resource "vsphere_host_local_user" "u1" {
+		hostname      = "%s"
+		username      = "%s"
+		password      = "%s"
+		description   = "%s"
+		role          = "%s"
+		host_username = "%s"
+		host_password = "%s"
+	}
+	`,
+		os.Getenv("TF_VAR_VSPHERE_ESXI1"),
+		hostLocalUserUsername,
+		password,
+		description,
+		role,
+		os.Getenv("TF_VAR_vsphere_esxi_ssh_user"),
+		os.Getenv("TF_VAR_vsphere_esxi_ssh_password"),
+	)
+}
@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func resourceVSphereHostPciPassthrough() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereHostPciPassthroughCreate,
+		Read:   resourceVSphereHostPciPassthroughRead,
+		Update: resourceVSphereHostPciPassthroughUpdate,
+		Delete: resourceVSphereHostPciPassthroughDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereHostPciPassthroughImport,
+		},
+		CustomizeDiff: resourceVSphereHostPciPassthroughCustomDiff,
+
+		Schema: map[string]*schema.Schema{
+			"host_system_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Host id of machine to configure pci passthrough on",
+				ExactlyOneOf: []string{"hostname"},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Hostname of machine to configure pci passthrough on",
+			},
+			"device": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Pci devices to configure.  Devices removed from config have passthrough and SR-IOV disabled",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Id of the pci device such as '0000:3b:00.0'",
+						},
+						"passthrough_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the device is available for passthrough to virtual machines",
+						},
+						"sriov_num_virtual_functions": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							Description:  "Number of SR-IOV virtual functions of the device.  SR-IOV is disabled when 0",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"reboot_if_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enter maintenance mode and reboot the host when the configuration only takes effect after a reboot.  Requires vcenter",
+			},
+			"reboot_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				Description:  "The timeout in seconds for entering maintenance mode and rebooting the host",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"reboot_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the host must be rebooted for the configuration of the devices to take effect",
+			},
+		},
+	}
+}
+
+func resourceVSphereHostPciPassthroughRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] reading pci passthrough config for host '%s'", host.Name())
+
+	current, err := hostconfig.GetHostPciPassthruInfo(client, host)
+	if err != nil {
+		return err
+	}
+
+	devices := make([]interface{}, 0)
+	rebootRequired := false
+
+	for _, v := range d.Get("device").(*schema.Set).List() {
+		id := v.(map[string]interface{})["id"].(string)
+
+		info, ok := current[id]
+		if !ok {
+			log.Printf("[DEBUG] pci device '%s' not found on host '%s'", id, host.Name())
+			continue
+		}
+
+		devices = append(devices, flattenHostPciPassthruInfo(info))
+		rebootRequired = rebootRequired || hostconfig.PciPassthruRebootRequired(info)
+	}
+
+	d.Set("device", devices)
+	d.Set("reboot_required", rebootRequired)
+
+	return nil
+}
+
+func resourceVSphereHostPciPassthroughCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] creating pci passthrough config for host '%s'", host.Name())
+
+	if err = vsphereHostPciPassthroughUpdate(d, client, host, nil); err != nil {
+		return err
+	}
+
+	d.SetId(hr.Value)
+
+	return resourceVSphereHostPciPassthroughRead(d, meta)
+}
+
+func resourceVSphereHostPciPassthroughUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] updating pci passthrough config for host '%s'", host.Name())
+
+	oldVal, newVal := d.GetChange("device")
+	newIDs := make(map[string]bool)
+	for _, v := range newVal.(*schema.Set).List() {
+		newIDs[v.(map[string]interface{})["id"].(string)] = true
+	}
+
+	removed := make([]string, 0)
+	for _, v := range oldVal.(*schema.Set).List() {
+		id := v.(map[string]interface{})["id"].(string)
+		if !newIDs[id] {
+			removed = append(removed, id)
+		}
+	}
+
+	if err = vsphereHostPciPassthroughUpdate(d, client, host, removed); err != nil {
+		return err
+	}
+
+	return resourceVSphereHostPciPassthroughRead(d, meta)
+}
+
+func resourceVSphereHostPciPassthroughDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] deleting pci passthrough config for host '%s'", host.Name())
+
+	current, err := hostconfig.GetHostPciPassthruInfo(client, host)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, 0)
+	config := make([]types.BaseHostPciPassthruConfig, 0)
+	for _, v := range d.Get("device").(*schema.Set).List() {
+		id := v.(map[string]interface{})["id"].(string)
+		ids = append(ids, id)
+
+		info, ok := current[id]
+		if !ok {
+			log.Printf("[DEBUG] pci device '%s' not found on host '%s', skipping", id, host.Name())
+			continue
+		}
+
+		if c := vsphereHostPciPassthroughConfig(info, false, 0); c != nil {
+			config = append(config, c)
+		}
+	}
+
+	if err = hostconfig.UpdateHostPciPassthruConfig(client, host, config); err != nil {
+		return err
+	}
+
+	return vsphereHostPciPassthroughRebootIfRequired(d, client, host, ids)
+}
+
+// resourceVSphereHostPciPassthroughImport imports all devices of the host that
+// have passthrough or SR-IOV enabled
+func resourceVSphereHostPciPassthroughImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.CheckIfHostnameOrID(client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := hostconfig.GetHostPciPassthruInfo(client, host)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]interface{}, 0)
+	rebootRequired := false
+	for _, info := range current {
+		device := flattenHostPciPassthruInfo(info)
+		if !device["passthrough_enabled"].(bool) && device["sriov_num_virtual_functions"].(int) == 0 {
+			continue
+		}
+
+		devices = append(devices, device)
+		rebootRequired = rebootRequired || hostconfig.PciPassthruRebootRequired(info)
+	}
+
+	d.SetId(d.Id())
+	d.Set(hr.IDName, hr.Value)
+	d.Set("device", devices)
+	d.Set("reboot_required", rebootRequired)
+	d.Set("reboot_if_required", false)
+	d.Set("reboot_timeout", 3600)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVSphereHostPciPassthroughCustomDiff(ctx context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+	ids := make(map[string]bool)
+
+	for _, v := range rd.Get("device").(*schema.Set).List() {
+		device := v.(map[string]interface{})
+		id := device["id"].(string)
+
+		if ids[id] {
+			return fmt.Errorf("duplicate values for 'id' attribute in 'device' block is not allowed")
+		}
+		ids[id] = true
+
+		if device["passthrough_enabled"].(bool) && device["sriov_num_virtual_functions"].(int) > 0 {
+			return fmt.Errorf("pci device '%s' can not have both passthrough and SR-IOV enabled", id)
+		}
+	}
+
+	// Rebooting requires vcenter, so reject it before the host is put in
+	// maintenance mode during apply
+	if rd.Get("reboot_if_required").(bool) {
+		if err := viapi.ValidateVirtualCenter(meta.(*Client).vimClient); err != nil {
+			return fmt.Errorf("reboot_if_required can only be set when connected to vcenter")
+		}
+	}
+
+	return nil
+}
+
+// vsphereHostPciPassthroughUpdate disables the removed devices, applies the
+// configured devices that differ from the host and reboots the host when
+// requested and required
+func vsphereHostPciPassthroughUpdate(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem, removed []string) error {
+	current, err := hostconfig.GetHostPciPassthruInfo(client, host)
+	if err != nil {
+		return err
+	}
+
+	config := make([]types.BaseHostPciPassthruConfig, 0)
+	ids := make([]string, 0)
+
+	for _, id := range removed {
+		if info, ok := current[id]; ok {
+			if c := vsphereHostPciPassthroughConfig(info, false, 0); c != nil {
+				config = append(config, c)
+			}
+		}
+	}
+
+	for _, v := range d.Get("device").(*schema.Set).List() {
+		device := v.(map[string]interface{})
+		id := device["id"].(string)
+		passthrough := device["passthrough_enabled"].(bool)
+		numVirtualFunctions := device["sriov_num_virtual_functions"].(int)
+		ids = append(ids, id)
+
+		info, ok := current[id]
+		if !ok {
+			return fmt.Errorf("pci device '%s' does not exist on host '%s'", id, host.Name())
+		}
+
+		if passthrough && !info.GetHostPciPassthruInfo().PassthruCapable {
+			return fmt.Errorf("pci device '%s' on host '%s' is not passthrough capable", id, host.Name())
+		}
+
+		if numVirtualFunctions > 0 {
+			sriov, ok := info.(*types.HostSriovInfo)
+			if !ok || !sriov.SriovCapable {
+				return fmt.Errorf("pci device '%s' on host '%s' is not SR-IOV capable", id, host.Name())
+			}
+
+			if int32(numVirtualFunctions) > sriov.MaxVirtualFunctionSupported {
+				return fmt.Errorf("pci device '%s' on host '%s' supports at most %d virtual functions", id, host.Name(), sriov.MaxVirtualFunctionSupported)
+			}
+		}
+
+		if c := vsphereHostPciPassthroughConfig(info, passthrough, numVirtualFunctions); c != nil {
+			config = append(config, c)
+		}
+	}
+
+	if err = hostconfig.UpdateHostPciPassthruConfig(client, host, config); err != nil {
+		return err
+	}
+
+	return vsphereHostPciPassthroughRebootIfRequired(d, client, host, append(ids, removed...))
+}
+
+// vsphereHostPciPassthroughConfig returns the config to apply to the device or
+// nil when the device is already configured as requested
+func vsphereHostPciPassthroughConfig(info types.BaseHostPciPassthruInfo, passthrough bool, numVirtualFunctions int) types.BaseHostPciPassthruConfig {
+	passthru := info.GetHostPciPassthruInfo()
+	config := types.HostPciPassthruConfig{
+		Id:              passthru.Id,
+		PassthruEnabled: passthrough,
+	}
+
+	if sriov, ok := info.(*types.HostSriovInfo); ok && sriov.SriovCapable {
+		requested := int32(0)
+		if sriov.SriovEnabled {
+			requested = sriov.NumVirtualFunctionRequested
+		}
+
+		if passthru.PassthruEnabled == passthrough && requested == int32(numVirtualFunctions) {
+			return nil
+		}
+
+		return &types.HostSriovConfig{
+			HostPciPassthruConfig: config,
+			SriovEnabled:          numVirtualFunctions > 0,
+			NumVirtualFunction:    int32(numVirtualFunctions),
+		}
+	}
+
+	if passthru.PassthruEnabled == passthrough {
+		return nil
+	}
+
+	return &config
+}
+
+// vsphereHostPciPassthroughRebootIfRequired enters maintenance mode, reboots
+// the host and exits maintenance mode again when reboot_if_required is set and
+// any of the given devices only takes effect after a reboot
+func vsphereHostPciPassthroughRebootIfRequired(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem, ids []string) error {
+	if !d.Get("reboot_if_required").(bool) {
+		return nil
+	}
+
+	current, err := hostconfig.GetHostPciPassthruInfo(client, host)
+	if err != nil {
+		return err
+	}
+
+	rebootRequired := false
+	for _, id := range ids {
+		if info, ok := current[id]; ok && hostconfig.PciPassthruRebootRequired(info) {
+			rebootRequired = true
+		}
+	}
+
+	if !rebootRequired {
+		return nil
+	}
+
+	timeout := time.Duration(d.Get("reboot_timeout").(int)) * time.Second
+
+	inMaintenance, err := hostsystem.HostInMaintenance(host)
+	if err != nil {
+		return fmt.Errorf("error while checking maintenance status for host '%s': %s", host.Name(), err)
+	}
+
+	if err = viapi.ValidateVirtualCenter(client); err != nil {
+		return fmt.Errorf("error rebooting host '%s': %s", host.Name(), err)
+	}
+
+	log.Printf("[INFO] rebooting host '%s' to apply pci passthrough config", host.Name())
+
+	if err = hostsystem.EnterMaintenanceMode(host, timeout, true); err != nil {
+		return fmt.Errorf("error while entering maintenance mode for host '%s': %s", host.Name(), err)
+	}
+
+	if err = hostsystem.RebootHost(host, timeout); err != nil {
+		// Don't leave the host evacuated when it wasn't in maintenance mode
+		// before.  The host may be unreachable, in which case exiting fails
+		// as well and both errors are returned
+		if !inMaintenance {
+			if exitErr := hostsystem.ExitMaintenanceMode(host, timeout); exitErr != nil {
+				return fmt.Errorf("error while rebooting host '%s': %s; error while exiting maintenance mode: %s", host.Name(), err, exitErr)
+			}
+		}
+
+		return fmt.Errorf("error while rebooting host '%s': %s", host.Name(), err)
+	}
+
+	if inMaintenance {
+		return nil
+	}
+
+	if err = hostsystem.ExitMaintenanceMode(host, timeout); err != nil {
+		return fmt.Errorf("error while exiting maintenance mode for host '%s': %s", host.Name(), err)
+	}
+
+	return nil
+}
+
+func flattenHostPciPassthruInfo(info types.BaseHostPciPassthruInfo) map[string]interface{} {
+	numVirtualFunctions := 0
+	if sriov, ok := info.(*types.HostSriovInfo); ok && sriov.SriovEnabled {
+		numVirtualFunctions = int(sriov.NumVirtualFunctionRequested)
+	}
+
+	return map[string]interface{}{
+		"id":                          info.GetHostPciPassthruInfo().Id,
+		"passthrough_enabled":         info.GetHostPciPassthruInfo().PassthruEnabled,
+		"sriov_num_virtual_functions": numVirtualFunctions,
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+)
+
+const hostPciPassthroughResourceName = "vsphere_host_pci_passthrough.h1"
+
+func TestAccResourceVSphereHostPciPassthrough_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariables(t, []string{"TF_VAR_VSPHERE_ESXI1", "TF_VAR_VSPHERE_PCI_DEVICE_ID"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereHostPciPassthroughValidate(false),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereHostPciPassthroughConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostPciPassthroughResourceName, "device.#", "1"),
+					resource.TestCheckResourceAttrSet(hostPciPassthroughResourceName, "reboot_required"),
+					testAccResourceVSphereHostPciPassthroughValidate(true),
+				),
+			},
+			{
+				Config: testAccResourceVSphereHostPciPassthroughConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceVSphereHostPciPassthroughValidate(false),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereHostPciPassthroughValidate(enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client).vimClient
+		host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+		if err != nil {
+			return err
+		}
+
+		devices, err := hostconfig.GetHostPciPassthruInfo(client, host)
+		if err != nil {
+			return err
+		}
+
+		id := os.Getenv("TF_VAR_VSPHERE_PCI_DEVICE_ID")
+		info, ok := devices[id]
+		if !ok {
+			return fmt.Errorf("pci device '%s' not found", id)
+		}
+
+		if info.GetHostPciPassthruInfo().PassthruEnabled != enabled {
+			return fmt.Errorf("pci device '%s' passthrough enabled should be %t; got %t", id, enabled, !enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereHostPciPassthroughConfig(enabled bool) string {
+	return fmt.Sprintf(`
+	resource "vsphere_host_pci_passthrough" "h1" {
+		hostname = "%s"
+
+		device {
+			id                  = "%s"
+			passthrough_enabled = %t
+		}
+	}
+	`,
+		os.Getenv("TF_VAR_VSPHERE_ESXI1"),
+		os.Getenv("TF_VAR_VSPHERE_PCI_DEVICE_ID"),
+		enabled,
+	)
+}
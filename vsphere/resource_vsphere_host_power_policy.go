@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+)
+
+// hostPowerPolicyDefault is the balanced power policy esxi hosts use by default
+const hostPowerPolicyDefault = "dynamic"
+
+func resourceVSphereHostPowerPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereHostPowerPolicyCreate,
+		Read:   resourceVSphereHostPowerPolicyRead,
+		Update: resourceVSphereHostPowerPolicyUpdate,
+		Delete: resourceVSphereHostPowerPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereHostPowerPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"host_system_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Host id of machine to set the power policy of",
+				ExactlyOneOf: []string{"hostname"},
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Hostname of machine to set the power policy of",
+			},
+			"policy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Short name of the power policy such as 'static' for high performance, 'dynamic' for balanced, 'low' for low power or 'custom'",
+			},
+			"policy_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Display name of the power policy",
+			},
+		},
+	}
+}
+
+func resourceVSphereHostPowerPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] reading power policy for host '%s'", host.Name())
+
+	return vsphereHostPowerPolicyRead(d, client, host)
+}
+
+func resourceVSphereHostPowerPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] setting power policy for host '%s'", host.Name())
+
+	if err = hostconfig.SetHostPowerPolicy(client, host, d.Get("policy").(string)); err != nil {
+		return err
+	}
+
+	d.SetId(hr.Value)
+
+	return vsphereHostPowerPolicyRead(d, client, host)
+}
+
+func resourceVSphereHostPowerPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] updating power policy for host '%s'", host.Name())
+
+	if err = hostconfig.SetHostPowerPolicy(client, host, d.Get("policy").(string)); err != nil {
+		return err
+	}
+
+	return vsphereHostPowerPolicyRead(d, client, host)
+}
+
+// resourceVSphereHostPowerPolicyDelete restores the balanced power policy
+// hosts use by default
+func resourceVSphereHostPowerPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	host, _, err := hostsystem.FromHostnameOrID(client, d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] resetting power policy for host '%s'", host.Name())
+
+	return hostconfig.SetHostPowerPolicy(client, host, hostPowerPolicyDefault)
+}
+
+func resourceVSphereHostPowerPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).vimClient
+	host, hr, err := hostsystem.CheckIfHostnameOrID(client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = vsphereHostPowerPolicyRead(d, client, host); err != nil {
+		return nil, err
+	}
+
+	d.SetId(d.Id())
+	d.Set(hr.IDName, hr.Value)
+	return []*schema.ResourceData{d}, nil
+}
+
+func vsphereHostPowerPolicyRead(d *schema.ResourceData, client *govmomi.Client, host *object.HostSystem) error {
+	powerSystem, err := hostconfig.GetHostPowerSystem(client, host)
+	if err != nil {
+		return err
+	}
+
+	d.Set("policy", powerSystem.Info.CurrentPolicy.ShortName)
+	d.Set("policy_name", powerSystem.Info.CurrentPolicy.Name)
+	return nil
+}
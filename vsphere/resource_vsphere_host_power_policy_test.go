@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+)
+
+const hostPowerPolicyResourceName = "vsphere_host_power_policy.h1"
+
+func TestAccResourceVSphereHostPowerPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariablesF(t, []string{"TF_VAR_VSPHERE_ESXI1"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereHostPowerPolicyValidate(hostPowerPolicyDefault),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereHostPowerPolicyConfig("static"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostPowerPolicyResourceName, "policy", "static"),
+					resource.TestCheckResourceAttrSet(hostPowerPolicyResourceName, "policy_name"),
+					testAccResourceVSphereHostPowerPolicyValidate("static"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereHostPowerPolicyConfig("low"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(hostPowerPolicyResourceName, "policy", "low"),
+					testAccResourceVSphereHostPowerPolicyValidate("low"),
+				),
+			},
+			{
+				ResourceName:      hostPowerPolicyResourceName,
+				ImportState:       true,
+				ImportStateId:     os.Getenv("TF_VAR_VSPHERE_ESXI1"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereHostPowerPolicyValidate(policy string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client).vimClient
+		host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+		if err != nil {
+			return err
+		}
+
+		powerSystem, err := hostconfig.GetHostPowerSystem(client, host)
+		if err != nil {
+			return err
+		}
+
+		if powerSystem.Info.CurrentPolicy.ShortName != policy {
+			return fmt.Errorf("power policy should be '%s'; got '%s'", policy, powerSystem.Info.CurrentPolicy.ShortName)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereHostPowerPolicyConfig(policy string) string {
+	return fmt.Sprintf(`
+	resource "vsphere_host_power_policy" "h1" {
+		hostname = "%s"
+		policy   = "%s"
+	}
+	`,
+		os.Getenv("TF_VAR_VSPHERE_ESXI1"),
+		policy,
+	)
+}
@@ -4,15 +4,18 @@
 package vsphere
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
 	"regexp"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/testhelper"
 
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 
 	"github.com/vmware/govmomi"
@@ -164,6 +167,46 @@ func TestAccResourceVSphereHost_lockdown(t *testing.T) {
 	})
 }
 
+func TestAccResourceVSphereHost_lockdownExceptionUsers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariables(t, []string{"ESX_HOSTNAME", "ESX_USERNAME", "ESX_PASSWORD"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVSphereHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testaccvspherehostconfigLockdownExceptionUsers("strict", true, os.Getenv("ESX_USERNAME")),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereHostExists("vsphere_host.h1"),
+					testAccVSphereHostLockdownState("vsphere_host.h1", "strict"),
+					resource.TestCheckTypeSetElemAttr("vsphere_host.h1", "lockdown_exception_users.*", os.Getenv("ESX_USERNAME")),
+					testAccVSphereHostLockdownExceptionUsers("vsphere_host.h1", 1),
+				),
+			},
+			{
+				// Removing the attribute stops managing the exception users
+				Config: testaccvspherehostconfigLockdownExceptionUsers("disabled", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereHostLockdownState("vsphere_host.h1", "disabled"),
+					resource.TestCheckTypeSetElemAttr("vsphere_host.h1", "lockdown_exception_users.*", os.Getenv("ESX_USERNAME")),
+					testAccVSphereHostLockdownExceptionUsers("vsphere_host.h1", 1),
+				),
+			},
+			{
+				Config: testaccvspherehostconfigLockdownExceptionUsers("disabled", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereHostLockdownState("vsphere_host.h1", "disabled"),
+					resource.TestCheckResourceAttr("vsphere_host.h1", "lockdown_exception_users.#", "0"),
+					testAccVSphereHostLockdownExceptionUsers("vsphere_host.h1", 0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccResourceVSphereHost_lockdown_invalid(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck: func() {
@@ -290,6 +333,38 @@ func testAccVSphereHostLockdownState(name string, lockdown string) resource.Test
 	}
 }
 
+func testAccVSphereHostLockdownExceptionUsers(name string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s key not found on the server", name)
+		}
+
+		client := testAccProvider.Meta().(*Client).vimClient
+		host, err := hostsystem.FromID(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		var hostProps mo.HostSystem
+		err = host.Properties(context.TODO(), host.Reference(), []string{"configManager.hostAccessManager"}, &hostProps)
+		if err != nil {
+			return err
+		}
+
+		users, err := NewHostAccessManager(client.Client, hostProps.ConfigManager.HostAccessManager.Reference()).QueryLockdownExceptions(context.TODO())
+		if err != nil {
+			return err
+		}
+
+		if len(users) != count {
+			return fmt.Errorf("Host with ID %s should have %d lockdown exception users. Current users: %v", rs.Primary.ID, count, users)
+		}
+
+		return nil
+	}
+}
+
 func testAccVSphereHostDestroy(s *terraform.State) error {
 	message := ""
 	for _, rs := range s.RootModule().Resources {
@@ -561,3 +636,45 @@ func testaccvspherehostconfigLockdown(lockdown string) string {
 		os.Getenv("TF_VAR_VSPHERE_LICENSE"),
 		lockdown)
 }
+
+func testaccvspherehostconfigLockdownExceptionUsers(lockdown string, manageUsers bool, users ...string) string {
+	usersAttr := ""
+	if manageUsers {
+		quoted := make([]string, 0, len(users))
+		for _, u := range users {
+			quoted = append(quoted, fmt.Sprintf("%q", u))
+		}
+
+		usersAttr = fmt.Sprintf("lockdown_exception_users = [%s]", strings.Join(quoted, ", "))
+	}
+
+	return fmt.Sprintf(`
+	%s
+
+	resource "vsphere_compute_cluster" "c1" {
+	  name = "%s"
+	  datacenter_id = data.vsphere_datacenter.rootdc1.id
+	}
+
+	resource "vsphere_host" "h1" {
+	  hostname = "%s"
+	  username = "%s"
+	  password = "%s"
+	  thumbprint = data.vsphere_host_thumbprint.id
+
+	  license = "%s"
+	  connected = "true"
+	  maintenance = "false"
+	  lockdown = "%s"
+	  %s
+	  cluster = vsphere_compute_cluster.c1.id
+	}
+	`, testhelper.ConfigDataRootDC1(),
+		"TestCluster",
+		os.Getenv("ESX_HOSTNAME"),
+		os.Getenv("ESX_USERNAME"),
+		os.Getenv("ESX_PASSWORD"),
+		os.Getenv("TF_VAR_VSPHERE_LICENSE"),
+		lockdown,
+		usersAttr)
+}
@@ -4,16 +4,31 @@
 package vsphere
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"context"
+	"log"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/customattribute"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/sso"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
 	"github.com/vmware/govmomi/ssoadmin"
 	ssoadmin_types "github.com/vmware/govmomi/ssoadmin/types"
 
 	"github.com/vmware/govmomi/ssoadmin/methods"
+)
 
+const (
+	// ldapServerTypeAD is active directory over ldap
+	ldapServerTypeAD = "ActiveDirectory"
+	// ldapServerTypeOpenLdap is an openldap server
+	ldapServerTypeOpenLdap = "OpenLdap"
+	// ldapServerTypeIWA is integrated windows authentication using the
+	// active directory domain vcenter is joined to
+	ldapServerTypeIWA = "IntegratedWindowsAuthentication"
 )
 
 var identitynotfound = errors.New("could not find identity source - this might be expected")
@@ -31,15 +46,16 @@ func resourceVSphereLDAPIdentitySource() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"ldap_username": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"ldap_password": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
 			},
 			"domain_alias": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 				ForceNew: true,
 			},
 			"domain_name": {
@@ -48,32 +64,48 @@ func resourceVSphereLDAPIdentitySource() *schema.Resource {
 				ForceNew: true,
 			},
 			"server_type": {
-				Type:     schema.TypeString,
-				ForceNew: true,
-				Default: "ActiveDirectory",
-				Optional: true,
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Default:      ldapServerTypeAD,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{ldapServerTypeAD, ldapServerTypeOpenLdap, ldapServerTypeIWA}, false),
 			},
 			"friendly_name": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"user_base_dn": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"group_base_dn": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"primary_url": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"failover_url": {
 				Type:     schema.TypeString,
-				Default: "",
+				Default:  "",
 				Optional: true,
 			},
+			"certificates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "PEM encoded certificates used to trust the ldaps servers",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: certificate.ValidatePEM(),
+				},
+			},
+			"default_domain": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Make this the default domain for logins without a domain",
+			},
 
 			// Add tags schema
 			vSphereTagAttributeKey: tagsSchema(),
@@ -86,46 +118,50 @@ func resourceVSphereLDAPIdentitySource() *schema.Resource {
 
 func resourceVSphereLDAPIdentitySourceCreate(d *schema.ResourceData, meta interface{}) error {
 	ssoclient := meta.(*Client).ssoClient
-	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
-	defer cancel()
+	domainName := d.Get("domain_name").(string)
 
-
-	_, err := identitySourceExists(ssoclient, d.Get("domain_name").(string))
+	_, err := identitySourceExists(ssoclient, domainName)
 	// check if the domain we are about to create already exists (we don't want it to)
 	if err == nil {
-		return fmt.Errorf("the domain %s already exists", d.Get("domain_name").(string))
+		return fmt.Errorf("the domain %s already exists", domainName)
 	}
 	if !errors.Is(err, identitynotfound) {
 		return fmt.Errorf("error getting currently configured ldap identity source: %s", err)
 	}
 
-	details := ssoadmin_types.LdapIdentitySourceDetails {
-		FriendlyName: d.Get("friendly_name").(string),
-		UserBaseDn: d.Get("user_base_dn").(string),
-		GroupBaseDn: d.Get("group_base_dn").(string),
-		PrimaryURL: d.Get("primary_url").(string),
-		FailoverURL: d.Get("failover_url").(string),
-	}
-	auth := ssoadmin_types.SsoAdminIdentitySourceManagementServiceAuthenticationCredentails {
-		Username: d.Get("ldap_username").(string),
-		Password: d.Get("ldap_password").(string),
-	}
+	log.Printf("[INFO] registering identity source '%s'", domainName)
 
-	// actually add the LDAP identity source to vcenter
-	err = ssoclient.RegisterLdap(ctx, d.Get("server_type").(string), d.Get("domain_name").(string), d.Get("domain_alias").(string), details, auth)
-	if err != nil {
-		return fmt.Errorf("error registering ldap: %s\n", err.Error())
+	if d.Get("server_type").(string) == ldapServerTypeIWA {
+		err = sso.RegisterActiveDirectory(ssoclient, domainName, expandLDAPIdentitySourceAuth(d))
+	} else {
+		var details *sso.LdapIdentitySourceDetails
+		if details, err = expandLDAPIdentitySourceDetails(d); err != nil {
+			return err
+		}
 
+		// actually add the LDAP identity source to vcenter
+		err = sso.RegisterLdap(ssoclient, d.Get("server_type").(string), domainName, d.Get("domain_alias").(string), *details, expandLDAPIdentitySourceAuth(d))
+	}
+	if err != nil {
+		return err
 	}
 
 	// add the resource into the terraform state
-	d.SetId(d.Get("domain_name").(string))
+	d.SetId(domainName)
+
+	if d.Get("default_domain").(bool) {
+		if err = sso.SetDefaultDomain(ssoclient, domainName); err != nil {
+			return err
+		}
+	}
 
 	return resourceVSphereLDAPIdentitySourceRead(d, meta)
 }
 
+// identitySourceExists finds the ldap identity source with the given domain
+// name.  The integrated windows authentication source is returned as an ldap
+// source with the IntegratedWindowsAuthentication type and no details
 func identitySourceExists(ssoclient *ssoadmin.Client, id string) (*ssoadmin_types.LdapIdentitySource, error) {
-
 	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
 	defer cancel()
 
@@ -141,28 +177,58 @@ func identitySourceExists(ssoclient *ssoadmin.Client, id string) (*ssoadmin_type
 		}
 	}
 
+	if nativeAD := Myidentitysources.NativeAD; nativeAD != nil {
+		for _, domain := range nativeAD.Domains {
+			if domain.Name == id {
+				return &ssoadmin_types.LdapIdentitySource{IdentitySource: *nativeAD, Type: ldapServerTypeIWA}, nil
+			}
+		}
+	}
+
 	return nil, identitynotfound
 }
 
 func resourceVSphereLDAPIdentitySourceRead(d *schema.ResourceData, meta interface{}) error {
 	ssoclient := meta.(*Client).ssoClient
 
-	// if the user specifies a LDAP source to be created that already exists in vcenter this will fail to be created as there is a name conflict
 	identitySource, err := identitySourceExists(ssoclient, d.Id())
 	if err != nil {
+		if errors.Is(err, identitynotfound) {
+			log.Printf("[DEBUG] identity source '%s' not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
 		return fmt.Errorf("Read func - error checking if existing ldap source exists: %s", err)
 	}
 
-	d.Set("domain_name", identitySource.Name)
-	d.Set("domain_alias", identitySource.Name)
+	defaultDomain, err := sso.IsDefaultDomain(ssoclient, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("domain_name", d.Id())
 	d.Set("server_type", identitySource.Type)
+	d.Set("default_domain", defaultDomain)
+
+	// integrated windows authentication sources have no ldap details
+	if identitySource.Type == ldapServerTypeIWA {
+		return nil
+	}
+
+	for _, domain := range identitySource.Domains {
+		if domain.Name == d.Id() {
+			d.Set("domain_alias", domain.Alias)
+		}
+	}
+
 	d.Set("friendly_name", identitySource.Details.FriendlyName)
 	d.Set("user_base_dn", identitySource.Details.UserBaseDn)
 	d.Set("group_base_dn", identitySource.Details.GroupBaseDn)
 	d.Set("primary_url", identitySource.Details.PrimaryURL)
 	d.Set("failover_url", identitySource.Details.FailoverURL)
 	d.Set("ldap_username", identitySource.AuthenticationDetails.Username)
-	// we are unable to get the password via the API for this
+	// we are unable to get the password or certificates via the API for this
 	d.Set("ldap_password", d.Get("ldap_password"))
 
 	return nil
@@ -173,8 +239,6 @@ func resourceVSphereLDAPIdentitySourceUpdate(d *schema.ResourceData, meta interf
 	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
 	defer cancel()
 
-
-
 	_, err := identitySourceExists(ssoclient, d.Get("domain_name").(string))
 	if err != nil {
 		// check if the domain we are about to create already exists (it should...) and we get no other errors
@@ -185,36 +249,40 @@ func resourceVSphereLDAPIdentitySourceUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-
-	if d.HasChanges("ldap_username", "ldap_password") {
-		auth := ssoadmin_types.SsoAdminIdentitySourceManagementServiceAuthenticationCredentails {
-			Username: d.Get("ldap_username").(string),
-			Password: d.Get("ldap_password").(string),
+	if d.Get("server_type").(string) != ldapServerTypeIWA {
+		if d.HasChanges("ldap_username", "ldap_password") {
+			err = ssoclient.UpdateLdapAuthnType(ctx, d.Get("domain_name").(string), *expandLDAPIdentitySourceAuth(d))
+			if err != nil {
+				return fmt.Errorf("error updating ldap username or password: %s", err)
+			}
 		}
 
-		err = ssoclient.UpdateLdapAuthnType(ctx, d.Get("domain_name").(string), auth)
-		if err != nil {
-			return fmt.Errorf("error updating ldap username or password: %s", err)
+		if d.HasChanges("friendly_name", "user_base_dn", "group_base_dn", "primary_url", "failover_url", "certificates") {
+			details, err := expandLDAPIdentitySourceDetails(d)
+			if err != nil {
+				return err
+			}
+
+			if err = sso.UpdateLdap(ssoclient, d.Get("domain_name").(string), *details); err != nil {
+				return err
+			}
 		}
 	}
 
-	if d.HasChanges("friendly_name", "user_base_dn", "group_base_dn", "primary_url", "secondary_url") {
-		details := ssoadmin_types.LdapIdentitySourceDetails {
-			FriendlyName: d.Get("friendly_name").(string),
-			UserBaseDn: d.Get("user_base_dn").(string),
-			GroupBaseDn: d.Get("group_base_dn").(string),
-			PrimaryURL: d.Get("primary_url").(string),
-			FailoverURL: d.Get("failover_url").(string),
+	if d.HasChange("default_domain") {
+		// the system domain takes over as default when this domain is no
+		// longer the default
+		defaultDomain := ssoclient.Domain
+		if d.Get("default_domain").(bool) {
+			defaultDomain = d.Get("domain_name").(string)
 		}
 
-		err = ssoclient.UpdateLdap(ctx, d.Get("domain_name").(string), details)
-
-		if err != nil {
-			return fmt.Errorf("error updating ldap details such as friendly name: %s", err)
+		if err = sso.SetDefaultDomain(ssoclient, defaultDomain); err != nil {
+			return err
 		}
 	}
 
-	return nil
+	return resourceVSphereLDAPIdentitySourceRead(d, meta)
 }
 
 func resourceVSphereLDAPIdentitySourceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -222,7 +290,6 @@ func resourceVSphereLDAPIdentitySourceDelete(d *schema.ResourceData, meta interf
 	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
 	defer cancel()
 
-
 	_, err := identitySourceExists(ssoclient, d.Get("domain_name").(string))
 	if err != nil {
 		// check if the domain we are about to create already exists (it should...) and we get no other errors
@@ -233,7 +300,15 @@ func resourceVSphereLDAPIdentitySourceDelete(d *schema.ResourceData, meta interf
 		}
 	}
 
-	a := ssoadmin_types.DeleteDomain {
+	// a default domain can't be deleted so hand the default back to the
+	// system domain first
+	if d.Get("default_domain").(bool) {
+		if err = sso.SetDefaultDomain(ssoclient, ssoclient.Domain); err != nil {
+			return err
+		}
+	}
+
+	a := ssoadmin_types.DeleteDomain{
 		This: ssoclient.ServiceContent.DomainManagementService,
 		Name: d.Get("domain_name").(string),
 	}
@@ -248,8 +323,10 @@ func resourceVSphereLDAPIdentitySourceDelete(d *schema.ResourceData, meta interf
 // NOTE: This import will create the resource within state successfully but the next 'terraform apply' WILL note some changes for it, even if there is nothing actually changing
 // this is due to our inability to fetch the currently configured passwords that LDAP is using and TF will enforce the ones defined in it.
 func resourceVSphereLDAPIdentitySourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-
 	ssoclient := meta.(*Client).ssoClient
+	if err := sso.CheckClient(ssoclient); err != nil {
+		return nil, err
+	}
 
 	// sanity check that the identity source actually exists in vcenter
 	_, err := identitySourceExists(ssoclient, d.Id())
@@ -258,20 +335,38 @@ func resourceVSphereLDAPIdentitySourceImport(d *schema.ResourceData, meta interf
 		return nil, fmt.Errorf("Import func - error checking if identity source exists: %s\n", err)
 	}
 
-	// If no errors verifying that the identity source does exist
-	d.SetId(d.Id())
+	// the domain name is the id so it can be used directly for the import
+	d.Set("domain_name", d.Id())
+	d.Set("default_domain", false)
 
 	return []*schema.ResourceData{d}, nil
-
 }
 
-
 // this function sanity checks that the domain you are trying to create / update with terraform is not going to create an error when you run a 'terraform apply'
 // - e.g. this function attempts to catch errors in a 'terraform plan'
 func resourceVSphereLDAPIdentitySourceCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// ldap sources need the connection details while integrated windows
+	// authentication uses the domain vcenter is joined to
+	if d.Get("server_type").(string) == ldapServerTypeIWA {
+		for _, key := range []string{"friendly_name", "user_base_dn", "group_base_dn", "primary_url", "failover_url", "certificates", "domain_alias"} {
+			if _, ok := d.GetOk(key); ok {
+				return fmt.Errorf("'%s' can't be set when server_type is %s", key, ldapServerTypeIWA)
+			}
+		}
+	} else {
+		for _, key := range []string{"ldap_username", "ldap_password", "domain_alias", "friendly_name", "user_base_dn", "group_base_dn", "primary_url"} {
+			if _, ok := d.GetOk(key); !ok && d.NewValueKnown(key) {
+				return fmt.Errorf("'%s' is required when server_type is %s", key, d.Get("server_type").(string))
+			}
+		}
+	}
+
 	// If the LDAP identity source does NOT exist in state yet...
 	if d.Id() == "" {
 		ssoclient := meta.(*Client).ssoClient
+		if err := sso.CheckClient(ssoclient); err != nil {
+			return err
+		}
 
 		// check to see if the identitysource exists - this is what alerts you to a possible issue via 'terraform plan' instead of the 'plan' saying all is good and the 'apply' actually failing
 		_, err := identitySourceExists(ssoclient, d.Get("domain_name").(string))
@@ -286,3 +381,32 @@ func resourceVSphereLDAPIdentitySourceCustomDiff(ctx context.Context, d *schema.
 
 	return nil
 }
+
+func expandLDAPIdentitySourceDetails(d *schema.ResourceData) (*sso.LdapIdentitySourceDetails, error) {
+	certs, err := sso.LdapCertificates(structure.SliceInterfacesToStrings(d.Get("certificates").([]interface{})))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ldap identity source certificates: %s", err)
+	}
+
+	return &sso.LdapIdentitySourceDetails{
+		FriendlyName: d.Get("friendly_name").(string),
+		UserBaseDn:   d.Get("user_base_dn").(string),
+		GroupBaseDn:  d.Get("group_base_dn").(string),
+		PrimaryURL:   d.Get("primary_url").(string),
+		FailoverURL:  d.Get("failover_url").(string),
+		Certificates: certs,
+	}, nil
+}
+
+// expandLDAPIdentitySourceAuth returns nil without a username so that
+// integrated windows authentication uses the vcenter machine account
+func expandLDAPIdentitySourceAuth(d *schema.ResourceData) *ssoadmin_types.SsoAdminIdentitySourceManagementServiceAuthenticationCredentails {
+	if d.Get("ldap_username").(string) == "" {
+		return nil
+	}
+
+	return &ssoadmin_types.SsoAdminIdentitySourceManagementServiceAuthenticationCredentails{
+		Username: d.Get("ldap_username").(string),
+		Password: d.Get("ldap_password").(string),
+	}
+}
@@ -147,3 +147,66 @@ func testAccVSphereLdapIdentitySourceWithFriendlyName(resource_name string, frie
 		return fmt.Errorf("unable to locate a matching identity source with friendly_name: %s", friendly_name)
 	}
 }
+
+func TestAccResourceVSphereLdapIdentitySource_ldaps(t *testing.T) {
+	resource_name := "vsphere_ldap_identity_source.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariables(t, []string{"ldap_username", "ldap_password", "domain_name", "domain_alias", "user_base_dn", "group_base_dn", "ldaps_primary_url", "ldaps_certificate_path"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVSphereLdapIdentitySourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereLdapIdentitySourceLdapsConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereLdapIdentitySourceExists(resource_name),
+					resource.TestCheckResourceAttr(resource_name, "default_domain", "false"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereLdapIdentitySourceLdapsConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_name, "default_domain", "true"),
+				),
+			},
+			{
+				// the domain goes back to not being the default so it can be deleted
+				Config: testAccResourceVSphereLdapIdentitySourceLdapsConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_name, "default_domain", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereLdapIdentitySourceLdapsConfig(defaultDomain bool) string {
+	return fmt.Sprintf(`
+	resource "vsphere_ldap_identity_source" "test" {
+		ldap_username  = "%s"
+		ldap_password  = "%s"
+		domain_name    = "%s"
+		domain_alias   = "%s"
+		server_type    = "ActiveDirectory"
+		friendly_name  = "ldaps"
+		user_base_dn   = "%s"
+		group_base_dn  = "%s"
+		primary_url    = "%s"
+		certificates   = [file("%s")]
+		default_domain = %t
+	}
+	`,
+		os.Getenv("ldap_username"),
+		os.Getenv("ldap_password"),
+		os.Getenv("domain_name"),
+		os.Getenv("domain_alias"),
+		os.Getenv("user_base_dn"),
+		os.Getenv("group_base_dn"),
+		os.Getenv("ldaps_primary_url"),
+		os.Getenv("ldaps_certificate_path"),
+		defaultDomain,
+	)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/provider"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/license"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// licenseEvaluationKey is the key assets without a license are assigned
+const licenseEvaluationKey = "00000-00000-00000-00000-00000"
+
+func resourceVSphereLicenseAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereLicenseAssignmentCreate,
+		Read:   resourceVSphereLicenseAssignmentRead,
+		Update: resourceVSphereLicenseAssignmentUpdate,
+		Delete: resourceVSphereLicenseAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereLicenseAssignmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"entity_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the asset to license such as the instance uuid of vcenter, the managed object id of a host or of a vsan cluster.  Defaults to the connected vcenter",
+			},
+			"license_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "License key to assign to the asset",
+			},
+			"entity_display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Display name of the licensed asset",
+			},
+			"edition_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Edition of the assigned license",
+			},
+		},
+	}
+}
+
+func resourceVSphereLicenseAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+	if err := viapi.ValidateVirtualCenter(client); err != nil {
+		return err
+	}
+
+	entityID := d.Get("entity_id").(string)
+	if entityID == "" {
+		entityID = client.ServiceContent.About.InstanceUuid
+	}
+
+	log.Printf("[INFO] assigning license to '%s'", entityID)
+
+	if err := vsphereLicenseAssignmentUpdate(client, entityID, d.Get("license_key").(string)); err != nil {
+		return err
+	}
+
+	d.SetId(entityID)
+	return resourceVSphereLicenseAssignmentRead(d, meta)
+}
+
+func resourceVSphereLicenseAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+
+	assignment, err := vsphereLicenseAssignmentQuery(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if assignment == nil {
+		log.Printf("[DEBUG] no license assigned to '%s', removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("entity_id", assignment.EntityId)
+	_ = d.Set("license_key", assignment.AssignedLicense.LicenseKey)
+	_ = d.Set("entity_display_name", assignment.EntityDisplayName)
+	_ = d.Set("edition_key", assignment.AssignedLicense.EditionKey)
+	return nil
+}
+
+func resourceVSphereLicenseAssignmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+
+	log.Printf("[INFO] updating license assigned to '%s'", d.Id())
+
+	if err := vsphereLicenseAssignmentUpdate(client, d.Id(), d.Get("license_key").(string)); err != nil {
+		return err
+	}
+
+	return resourceVSphereLicenseAssignmentRead(d, meta)
+}
+
+func resourceVSphereLicenseAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).vimClient
+
+	log.Printf("[INFO] removing license assigned to '%s'", d.Id())
+
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	lam, err := license.NewManager(client.Client).AssignmentManager(ctx)
+	if err != nil {
+		return fmt.Errorf("error while accessing License Assignment Manager endpoint. Error: %s", err)
+	}
+
+	if err = lam.Remove(ctx, d.Id()); err != nil {
+		return fmt.Errorf("error while removing license assigned to %s. Error: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceVSphereLicenseAssignmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).vimClient
+	if err := viapi.ValidateVirtualCenter(client); err != nil {
+		return nil, err
+	}
+
+	assignment, err := vsphereLicenseAssignmentQuery(client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if assignment == nil {
+		return nil, fmt.Errorf("no license assigned to '%s'", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// vsphereLicenseAssignmentQuery returns the license assigned to the asset or
+// nil when it has none or is in evaluation mode
+func vsphereLicenseAssignmentQuery(client *govmomi.Client, entityID string) (*types.LicenseAssignmentManagerLicenseAssignment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	lam, err := license.NewManager(client.Client).AssignmentManager(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while accessing License Assignment Manager endpoint. Error: %s", err)
+	}
+
+	assignments, err := lam.QueryAssigned(ctx, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("error while querying license assigned to %s. Error: %s", entityID, err)
+	}
+
+	for _, assignment := range assignments {
+		if assignment.EntityId == entityID && assignment.AssignedLicense.LicenseKey != licenseEvaluationKey {
+			a := assignment
+			return &a, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func vsphereLicenseAssignmentUpdate(client *govmomi.Client, entityID, licenseKey string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), provider.DefaultAPITimeout)
+	defer cancel()
+
+	lam, err := license.NewManager(client.Client).AssignmentManager(ctx)
+	if err != nil {
+		return fmt.Errorf("error while accessing License Assignment Manager endpoint. Error: %s", err)
+	}
+
+	info, err := lam.Update(ctx, entityID, licenseKey, "")
+	if err != nil {
+		return fmt.Errorf("error while assigning license to %s. Error: %s", entityID, err)
+	}
+
+	return DecodeError(*info)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/testhelper"
+)
+
+const licenseAssignmentResourceName = "vsphere_license_assignment.host"
+
+func TestAccResourceVSphereLicenseAssignment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccSkipIfEsxi(t)
+			testAccCheckEnvVariablesF(t, []string{"TF_VAR_VSPHERE_ESXI1", "TF_VAR_VSPHERE_LICENSE"})
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereLicenseAssignmentRemoved,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereLicenseAssignmentConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(licenseAssignmentResourceName, "license_key", os.Getenv("TF_VAR_VSPHERE_LICENSE")),
+					resource.TestCheckResourceAttrPair(licenseAssignmentResourceName, "entity_id", "data.vsphere_host.roothost1", "id"),
+					resource.TestCheckResourceAttrSet(licenseAssignmentResourceName, "edition_key"),
+					resource.TestCheckResourceAttrSet(licenseAssignmentResourceName, "entity_display_name"),
+				),
+			},
+			{
+				ResourceName:      licenseAssignmentResourceName,
+				Config:            testAccResourceVSphereLicenseAssignmentConfig(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereLicenseAssignmentRemoved(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client).vimClient
+	host, err := hostsystem.FromHostname(client, os.Getenv("TF_VAR_VSPHERE_ESXI1"))
+	if err != nil {
+		return err
+	}
+
+	assignment, err := vsphereLicenseAssignmentQuery(client, host.Reference().Value)
+	if err != nil {
+		return err
+	}
+
+	if assignment != nil {
+		return fmt.Errorf("host '%s' still has license '%s'", host.Name(), assignment.AssignedLicense.LicenseKey)
+	}
+
+	return nil
+}
+
+func testAccResourceVSphereLicenseAssignmentConfig() string {
+	return fmt.Sprintf(`
+%s
+
+resource "vsphere_license_assignment" "host" {
+  entity_id   = data.vsphere_host.roothost1.id
+  license_key = "%s"
+}
+`,
+		testhelper.CombineConfigs(testhelper.ConfigDataRootDC1(), testhelper.ConfigDataRootHost1()),
+		os.Getenv("TF_VAR_VSPHERE_LICENSE"),
+	)
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/administrationroles"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
 	"github.com/vmware/govmomi/object"
 )
@@ -28,7 +29,8 @@ func resourceVsphereRole() *schema.Resource {
 		"role_privileges": {
 			Type:             schema.TypeList,
 			Optional:         true,
-			Description:      "The privileges to be associated with the role.",
+			Computed:         true,
+			Description:      "The privileges to be associated with the role. Glob patterns such as VirtualMachine.* are expanded into the privileges they match.",
 			Elem:             &schema.Schema{Type: schema.TypeString},
 			DiffSuppressFunc: privilegesDiffCheck,
 		},
@@ -40,11 +42,12 @@ func resourceVsphereRole() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceRoleCreate,
-		Read:   resourceRoleRead,
-		Update: resourceRoleUpdate,
-		Delete: resourceRoleDelete,
-		Schema: sch,
+		Create:        resourceRoleCreate,
+		Read:          resourceRoleRead,
+		Update:        resourceRoleUpdate,
+		Delete:        resourceRoleDelete,
+		Schema:        sch,
+		CustomizeDiff: resourceVSphereRoleCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceRoleImport,
 		},
@@ -179,3 +182,51 @@ func privilegesDiffCheck(_, _, _ string, d *schema.ResourceData) bool {
 	}
 	return true
 }
+
+// resourceVSphereRoleCustomizeDiff validates the privileges against the
+// privileges of the server and expands glob patterns so invalid privileges
+// fail at plan time and the plan shows the privileges that will be set
+func resourceVSphereRoleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("role_privileges") {
+		return nil
+	}
+
+	// the privileges are computed to allow expanding them, so privileges
+	// removed from config have to be cleared here
+	var configured []string
+	if !d.GetRawConfig().GetAttr("role_privileges").IsNull() {
+		configured = structure.SliceInterfacesToStrings(d.Get("role_privileges").([]interface{}))
+	}
+
+	oldVal, _ := d.GetChange("role_privileges")
+	oldArr := structure.SliceInterfacesToStrings(oldVal.([]interface{}))
+
+	hasPattern := false
+	for _, privilege := range configured {
+		if administrationroles.IsPrivilegePattern(privilege) {
+			hasPattern = true
+			break
+		}
+	}
+
+	if !hasPattern && !d.HasChange("role_privileges") && len(configured) == len(oldArr) {
+		return nil
+	}
+
+	privileges, err := administrationroles.PrivilegeList(meta.(*Client).vimClient)
+	if err != nil {
+		return err
+	}
+
+	expanded, err := administrationroles.ExpandPrivileges(privileges, configured)
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(oldArr)
+	if strings.Join(oldArr, ",") == strings.Join(expanded, ",") {
+		return d.SetNew("role_privileges", oldVal)
+	}
+
+	return d.SetNew("role_privileges", structure.SliceStringsToInterfaces(expanded))
+}
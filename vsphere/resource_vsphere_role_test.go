@@ -133,6 +133,49 @@ func TestAccResourceVsphereRole_importSystemRoleShouldError(t *testing.T) {
 	})
 }
 
+func TestAccResourceVsphereRole_globPrivileges(t *testing.T) {
+	roleName := "terraform_role" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVsphereRoleCheckExists(false),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVsphereRoleConfigPrivileges(roleName, `"Alarm.*", "Datacenter.Move"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceVsphereRoleCheckExists(true),
+					resource.TestCheckTypeSetElemAttr("vsphere_role."+RoleResource, "role_privileges.*", Privilege1),
+					resource.TestCheckTypeSetElemAttr("vsphere_role."+RoleResource, "role_privileges.*", Privilege2),
+					resource.TestCheckTypeSetElemAttr("vsphere_role."+RoleResource, "role_privileges.*", Privilege4),
+				),
+			},
+			{
+				Config:   testAccResourceVsphereRoleConfigPrivileges(roleName, `"Alarm.*", "Datacenter.Move"`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceVsphereRole_invalidPrivilegeShouldError(t *testing.T) {
+	roleName := "terraform_role" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceVsphereRoleConfigPrivileges(roleName, `"Alarm.DoesNotExist"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("privilege Alarm.DoesNotExist does not exist"),
+			},
+		},
+	})
+}
+
 func testAccResourceVsphereRoleCheckExists(expected bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := testGetVsphereRole(s, RoleResource)
@@ -178,6 +221,18 @@ func testAccResourceVsphereRoleConfigAdditionalPrivileges(roleName string) strin
 	)
 }
 
+func testAccResourceVsphereRoleConfigPrivileges(roleName, privileges string) string {
+	return fmt.Sprintf(`
+  resource "vsphere_role" "%s" {
+  name = "%s"
+  role_privileges = [%s]
+}
+`, RoleResource,
+		roleName,
+		privileges,
+	)
+}
+
 func testAccResourceVsphereRoleConfigSystemRole() string {
 	return fmt.Sprintf(`
   resource "vsphere_role" "%s" {
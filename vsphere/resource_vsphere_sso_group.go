@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/sso"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
+	"github.com/vmware/govmomi/ssoadmin"
+	ssoadmin_types "github.com/vmware/govmomi/ssoadmin/types"
+)
+
+func resourceVSphereSSOGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceVSphereSSOGroupCreate,
+		Read:          resourceVSphereSSOGroupRead,
+		Update:        resourceVSphereSSOGroupUpdate,
+		Delete:        resourceVSphereSSOGroupDelete,
+		CustomizeDiff: resourceVSphereSSOGroupCustomDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereSSOGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the group in the sso system domain",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the group",
+			},
+			"users": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Users that are members of the group in the form 'name@domain'.  When set, users not in the list are removed from the group",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Groups that are members of the group in the form 'name@domain'.  When set, groups not in the list are removed from the group",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Sso system domain the group is in",
+			},
+		},
+	}
+}
+
+func resourceVSphereSSOGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).ssoClient
+	name := d.Get("name").(string)
+
+	log.Printf("[INFO] creating sso group '%s'", name)
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+	defer cancel()
+
+	details := ssoadmin_types.AdminGroupDetails{Description: d.Get("description").(string)}
+	if err := client.CreateGroup(ctx, name, details); err != nil {
+		return fmt.Errorf("error creating sso group '%s': %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := vsphereSSOGroupUpdateMembers(d, client); err != nil {
+		return err
+	}
+
+	return resourceVSphereSSOGroupRead(d, meta)
+}
+
+func resourceVSphereSSOGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).ssoClient
+
+	group, err := vsphereSSOGroupFind(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if group == nil {
+		log.Printf("[DEBUG] sso group '%s' not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	users, groups, err := sso.GroupMembers(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", group.Id.Name)
+	d.Set("domain", group.Id.Domain)
+	d.Set("description", group.Details.Description)
+	d.Set("users", flattenSSOGroupMembers(d.Get("users").(*schema.Set), users))
+	d.Set("groups", flattenSSOGroupMembers(d.Get("groups").(*schema.Set), groups))
+	return nil
+}
+
+func resourceVSphereSSOGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).ssoClient
+
+	log.Printf("[INFO] updating sso group '%s'", d.Id())
+
+	if d.HasChange("description") {
+		ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+		defer cancel()
+
+		details := ssoadmin_types.AdminGroupDetails{Description: d.Get("description").(string)}
+		if err := client.UpdateGroup(ctx, d.Id(), details); err != nil {
+			return fmt.Errorf("error updating sso group '%s': %s", d.Id(), err)
+		}
+	}
+
+	if err := vsphereSSOGroupUpdateMembers(d, client); err != nil {
+		return err
+	}
+
+	return resourceVSphereSSOGroupRead(d, meta)
+}
+
+func resourceVSphereSSOGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).ssoClient
+
+	log.Printf("[INFO] deleting sso group '%s'", d.Id())
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+	defer cancel()
+
+	if err := client.DeletePrincipal(ctx, d.Id()); err != nil {
+		return fmt.Errorf("error deleting sso group '%s': %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceVSphereSSOGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).ssoClient
+	if err := sso.CheckClient(client); err != nil {
+		return nil, err
+	}
+
+	group, err := vsphereSSOGroupFind(client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if group == nil {
+		return nil, fmt.Errorf("sso group '%s' does not exist", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVSphereSSOGroupCustomDiff(ctx context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*Client).ssoClient
+	if err := sso.CheckClient(client); err != nil {
+		return err
+	}
+
+	if rd.Id() == "" {
+		group, err := vsphereSSOGroupFind(client, rd.Get("name").(string))
+		if err != nil {
+			return err
+		}
+
+		if group != nil {
+			return fmt.Errorf("sso group '%s' already exists - consider running a 'terraform import'", rd.Get("name").(string))
+		}
+	}
+
+	return nil
+}
+
+func vsphereSSOGroupFind(client *ssoadmin.Client, name string) (*ssoadmin_types.AdminGroup, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+	defer cancel()
+
+	group, err := client.FindGroup(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sso group '%s': %s", name, err)
+	}
+
+	return group, nil
+}
+
+// vsphereSSOGroupUpdateMembers makes the members of the group match the users
+// and groups in config.  Members are left alone when users or groups isn't set,
+// while an empty list removes all members of that kind
+func vsphereSSOGroupUpdateMembers(d *schema.ResourceData, client *ssoadmin.Client) error {
+	usersOk := !d.GetRawConfig().GetAttr("users").IsNull()
+	groupsOk := !d.GetRawConfig().GetAttr("groups").IsNull()
+	if !usersOk && !groupsOk {
+		return nil
+	}
+
+	currentUsers, currentGroups, err := sso.GroupMembers(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	var addUsers, addGroups, remove []string
+
+	if usersOk {
+		wantUsers := structure.SliceInterfacesToStrings(d.Get("users").(*schema.Set).List())
+		addUsers = ssoPrincipalsDifference(wantUsers, currentUsers)
+		remove = append(remove, ssoPrincipalsDifference(currentUsers, wantUsers)...)
+	}
+
+	if groupsOk {
+		wantGroups := structure.SliceInterfacesToStrings(d.Get("groups").(*schema.Set).List())
+		addGroups = ssoPrincipalsDifference(wantGroups, currentGroups)
+		remove = append(remove, ssoPrincipalsDifference(currentGroups, wantGroups)...)
+	}
+
+	if err = sso.RemoveGroupMembers(client, d.Id(), remove); err != nil {
+		return err
+	}
+
+	return sso.AddGroupMembers(client, d.Id(), addUsers, addGroups)
+}
+
+// flattenSSOGroupMembers keeps the spelling of principals from config since
+// sso compares names and domains case insensitively
+func flattenSSOGroupMembers(current *schema.Set, members []string) []string {
+	configured := structure.SliceInterfacesToStrings(current.List())
+	result := make([]string, 0, len(members))
+
+	for _, m := range members {
+		name := m
+		for _, c := range configured {
+			if strings.EqualFold(c, m) {
+				name = c
+				break
+			}
+		}
+
+		result = append(result, name)
+	}
+
+	return result
+}
+
+// ssoPrincipalsDifference returns the principals of a that are not in b
+func ssoPrincipalsDifference(a, b []string) []string {
+	var diff []string
+
+	for _, p := range a {
+		found := false
+		for _, o := range b {
+			if strings.EqualFold(p, o) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			diff = append(diff, p)
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/sso"
+)
+
+const (
+	ssoGroupResourceName = "vsphere_sso_group.g1"
+)
+
+func TestAccResourceVSphereSSOGroup_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccSkipIfEsxi(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereSSOGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereSSOGroupConfig(
+					`"${vsphere_sso_user.u1.username}@${vsphere_sso_user.u1.domain}"`,
+					`"${vsphere_sso_group.member.name}@${vsphere_sso_group.member.domain}"`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ssoGroupResourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(ssoGroupResourceName, "groups.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereSSOGroupConfig(
+					"",
+					`"${vsphere_sso_group.member.name}@${vsphere_sso_group.member.domain}"`,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ssoGroupResourceName, "users.#", "0"),
+					resource.TestCheckResourceAttr(ssoGroupResourceName, "groups.#", "1"),
+					testAccResourceVSphereSSOGroupMembers(0, 1),
+				),
+			},
+			{
+				Config: testAccResourceVSphereSSOGroupConfig("", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ssoGroupResourceName, "users.#", "0"),
+					resource.TestCheckResourceAttr(ssoGroupResourceName, "groups.#", "0"),
+					testAccResourceVSphereSSOGroupMembers(0, 0),
+				),
+			},
+			{
+				ResourceName:      ssoGroupResourceName,
+				Config:            testAccResourceVSphereSSOGroupConfig("", ""),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereSSOGroupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vsphere_sso_group" {
+			continue
+		}
+
+		group, err := testAccProvider.Meta().(*Client).ssoClient.FindGroup(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if group != nil {
+			return fmt.Errorf("sso group '%s' still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+// testAccResourceVSphereSSOGroupMembers checks the members of the group on
+// vcenter so an emptied list can't be masked by state
+func testAccResourceVSphereSSOGroupMembers(userCount, groupCount int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[ssoGroupResourceName]
+		if !ok {
+			return fmt.Errorf("%s not found in state", ssoGroupResourceName)
+		}
+
+		users, groups, err := sso.GroupMembers(testAccProvider.Meta().(*Client).ssoClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(users) != userCount || len(groups) != groupCount {
+			return fmt.Errorf("sso group '%s' should have %d users and %d groups; got %d and %d", rs.Primary.ID, userCount, groupCount, len(users), len(groups))
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereSSOGroupConfig(user, group string) string {
+	return fmt.Sprintf(`
+	resource "vsphere_sso_user" "u1" {
+		username = "tf-acc-test-member"
+		password = "Tf-Acc-Test-Pa55!"
+	}
+
+	resource "vsphere_sso_group" "member" {
+		name = "tf-acc-test-member-group"
+	}
+
+	resource "vsphere_sso_group" "g1" {
+		name        = "tf-acc-test-group"
+		description = "terraform acceptance test"
+		users       = [%s]
+		groups      = [%s]
+	}
+	`,
+		user,
+		group,
+	)
+}
@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/sso"
+	"github.com/vmware/govmomi/ssoadmin"
+	ssoadmin_types "github.com/vmware/govmomi/ssoadmin/types"
+)
+
+const (
+	vsphereSSOPoliciesID = "tf-sso-policies"
+
+	// Default sso policy values of a newly deployed vcenter
+	ssoDefaultPasswordLifetimeDays             = 90
+	ssoDefaultProhibitedPreviousPasswordsCount = 5
+	ssoDefaultPasswordMinLength                = 8
+	ssoDefaultPasswordMaxLength                = 20
+	ssoDefaultPasswordMinAlphabeticCount       = 2
+	ssoDefaultPasswordMinUppercaseCount        = 1
+	ssoDefaultPasswordMinLowercaseCount        = 1
+	ssoDefaultPasswordMinNumericCount          = 1
+	ssoDefaultPasswordMinSpecialCharCount      = 1
+	ssoDefaultPasswordMaxIdenticalAdjacent     = 3
+	ssoDefaultLockoutMaxFailedAttempts         = 5
+	ssoDefaultLockoutFailedAttemptInterval     = 180
+	ssoDefaultLockoutAutoUnlockInterval        = 300
+	ssoDefaultMaxBearerTokenLifetime           = 300
+	ssoDefaultMaxHoKTokenLifetime              = 2592000
+	ssoDefaultClockTolerance                   = 600
+	ssoDefaultTokenDelegationCount             = 10
+	ssoDefaultTokenRenewCount                  = 10
+)
+
+func resourceVSphereSSOPolicies() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereSSOPoliciesCreate,
+		Read:   resourceVSphereSSOPoliciesRead,
+		Update: resourceVSphereSSOPoliciesUpdate,
+		Delete: resourceVSphereSSOPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereSSOPoliciesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"password_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Password policy of the sso system domain.  Not managed when omitted",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the password policy",
+						},
+						"password_lifetime_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultPasswordLifetimeDays,
+							Description:  "Maximum number of days a password can be used before it must be changed.  0 means passwords never expire",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"prohibited_previous_passwords_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultProhibitedPreviousPasswordsCount,
+							Description:  "Number of previous passwords that can't be reused",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min_length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultPasswordMinLength,
+							Description:  "Minimum length of a password",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultPasswordMaxLength,
+							Description:  "Maximum length of a password",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min_alphabetic_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultPasswordMinAlphabeticCount,
+							Description:  "Minimum number of alphabetic characters in a password",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min_uppercase_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultPasswordMinUppercaseCount,
+							Description:  "Minimum number of uppercase characters in a password",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min_lowercase_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultPasswordMinLowercaseCount,
+							Description:  "Minimum number of lowercase characters in a password",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min_numeric_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultPasswordMinNumericCount,
+							Description:  "Minimum number of numeric characters in a password",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min_special_char_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultPasswordMinSpecialCharCount,
+							Description:  "Minimum number of special characters in a password",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"max_identical_adjacent_characters": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultPasswordMaxIdenticalAdjacent,
+							Description:  "Maximum number of identical adjacent characters in a password",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"lockout_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Lockout policy of the sso system domain.  Not managed when omitted",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the lockout policy",
+						},
+						"max_failed_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultLockoutMaxFailedAttempts,
+							Description:  "Number of failed logins within failed_attempt_interval before a user is locked",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"failed_attempt_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultLockoutFailedAttemptInterval,
+							Description:  "Time in seconds in which failed logins are counted",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"auto_unlock_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultLockoutAutoUnlockInterval,
+							Description:  "Time in seconds after which a locked user is unlocked.  0 means users must be unlocked by an administrator",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			"token_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Token policy of the sso system domain.  Not managed when omitted",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_bearer_token_lifetime": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultMaxBearerTokenLifetime,
+							Description:  "Maximum lifetime in seconds of bearer tokens",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_hok_token_lifetime": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultMaxHoKTokenLifetime,
+							Description:  "Maximum lifetime in seconds of holder-of-key tokens",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"clock_tolerance": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultClockTolerance,
+							Description:  "Time difference in seconds allowed between clients and the sso server",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"delegation_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultTokenDelegationCount,
+							Description:  "Maximum number of times a token can be delegated",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"renew_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      ssoDefaultTokenRenewCount,
+							Description:  "Maximum number of times a token can be renewed",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVSphereSSOPoliciesCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] creating sso policies")
+
+	if err := vsphereSSOPoliciesUpdate(d, meta.(*Client).ssoClient, false); err != nil {
+		return err
+	}
+
+	d.SetId(vsphereSSOPoliciesID)
+	return resourceVSphereSSOPoliciesRead(d, meta)
+}
+
+func resourceVSphereSSOPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	return vsphereSSOPoliciesRead(d, meta.(*Client).ssoClient, false)
+}
+
+func resourceVSphereSSOPoliciesUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] updating sso policies")
+
+	if err := vsphereSSOPoliciesUpdate(d, meta.(*Client).ssoClient, false); err != nil {
+		return err
+	}
+
+	return resourceVSphereSSOPoliciesRead(d, meta)
+}
+
+// resourceVSphereSSOPoliciesDelete restores the defaults of the policies that
+// are managed
+func resourceVSphereSSOPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] restoring default sso policies")
+
+	return vsphereSSOPoliciesUpdate(d, meta.(*Client).ssoClient, true)
+}
+
+func resourceVSphereSSOPoliciesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != vsphereSSOPoliciesID {
+		return nil, fmt.Errorf("invalid import.  Import should simply be '%s'", vsphereSSOPoliciesID)
+	}
+
+	if err := vsphereSSOPoliciesRead(d, meta.(*Client).ssoClient, true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// vsphereSSOPoliciesRead only reads back the policies that are managed unless
+// allPolicies is set, which is used by import and the data source
+func vsphereSSOPoliciesRead(d *schema.ResourceData, client *ssoadmin.Client, allPolicies bool) error {
+	if err := sso.CheckClient(client); err != nil {
+		return err
+	}
+
+	if _, ok := d.GetOk("password_policy"); ok || allPolicies {
+		policy, err := sso.GetPasswordPolicy(client)
+		if err != nil {
+			return err
+		}
+
+		d.Set("password_policy", flattenSSOPasswordPolicy(policy))
+	}
+
+	if _, ok := d.GetOk("lockout_policy"); ok || allPolicies {
+		policy, err := sso.GetLockoutPolicy(client)
+		if err != nil {
+			return err
+		}
+
+		d.Set("lockout_policy", flattenSSOLockoutPolicy(policy))
+	}
+
+	if _, ok := d.GetOk("token_policy"); ok || allPolicies {
+		policy, err := sso.GetTokenPolicy(client)
+		if err != nil {
+			return err
+		}
+
+		d.Set("token_policy", flattenSSOTokenPolicy(policy))
+	}
+
+	return nil
+}
+
+func vsphereSSOPoliciesUpdate(d *schema.ResourceData, client *ssoadmin.Client, isDelete bool) error {
+	if err := sso.CheckClient(client); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("password_policy"); ok && (isDelete || d.HasChange("password_policy")) {
+		policy := ssoadmin_types.AdminPasswordPolicy{
+			ProhibitedPreviousPasswordsCount: ssoDefaultProhibitedPreviousPasswordsCount,
+			PasswordLifetimeDays:             ssoDefaultPasswordLifetimeDays,
+			PasswordFormat: ssoadmin_types.AdminPasswordFormat{
+				LengthRestriction: ssoadmin_types.AdminPasswordFormatLengthRestriction{
+					MinLength: ssoDefaultPasswordMinLength,
+					MaxLength: ssoDefaultPasswordMaxLength,
+				},
+				AlphabeticRestriction: ssoadmin_types.AdminPasswordFormatAlphabeticRestriction{
+					MinAlphabeticCount: ssoDefaultPasswordMinAlphabeticCount,
+					MinUppercaseCount:  ssoDefaultPasswordMinUppercaseCount,
+					MinLowercaseCount:  ssoDefaultPasswordMinLowercaseCount,
+				},
+				MinNumericCount:                ssoDefaultPasswordMinNumericCount,
+				MinSpecialCharCount:            ssoDefaultPasswordMinSpecialCharCount,
+				MaxIdenticalAdjacentCharacters: ssoDefaultPasswordMaxIdenticalAdjacent,
+			},
+		}
+
+		if !isDelete {
+			policy = expandSSOPasswordPolicy(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if err := sso.UpdatePasswordPolicy(client, policy); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("lockout_policy"); ok && (isDelete || d.HasChange("lockout_policy")) {
+		policy := ssoadmin_types.AdminLockoutPolicy{
+			MaxFailedAttempts:        ssoDefaultLockoutMaxFailedAttempts,
+			FailedAttemptIntervalSec: ssoDefaultLockoutFailedAttemptInterval,
+			AutoUnlockIntervalSec:    ssoDefaultLockoutAutoUnlockInterval,
+		}
+
+		if !isDelete {
+			policy = expandSSOLockoutPolicy(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if err := sso.UpdateLockoutPolicy(client, policy); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("token_policy"); ok && (isDelete || d.HasChange("token_policy")) {
+		policy := sso.TokenPolicy{
+			MaxBearerTokenLifetime: ssoDefaultMaxBearerTokenLifetime * 1000,
+			MaxHoKTokenLifetime:    ssoDefaultMaxHoKTokenLifetime * 1000,
+			ClockTolerance:         ssoDefaultClockTolerance * 1000,
+			DelegationCount:        ssoDefaultTokenDelegationCount,
+			RenewCount:             ssoDefaultTokenRenewCount,
+		}
+
+		if !isDelete {
+			policy = expandSSOTokenPolicy(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if err := sso.UpdateTokenPolicy(client, policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func expandSSOPasswordPolicy(m map[string]interface{}) ssoadmin_types.AdminPasswordPolicy {
+	return ssoadmin_types.AdminPasswordPolicy{
+		Description:                      m["description"].(string),
+		ProhibitedPreviousPasswordsCount: int32(m["prohibited_previous_passwords_count"].(int)),
+		PasswordLifetimeDays:             int32(m["password_lifetime_days"].(int)),
+		PasswordFormat: ssoadmin_types.AdminPasswordFormat{
+			LengthRestriction: ssoadmin_types.AdminPasswordFormatLengthRestriction{
+				MinLength: int32(m["min_length"].(int)),
+				MaxLength: int32(m["max_length"].(int)),
+			},
+			AlphabeticRestriction: ssoadmin_types.AdminPasswordFormatAlphabeticRestriction{
+				MinAlphabeticCount: int32(m["min_alphabetic_count"].(int)),
+				MinUppercaseCount:  int32(m["min_uppercase_count"].(int)),
+				MinLowercaseCount:  int32(m["min_lowercase_count"].(int)),
+			},
+			MinNumericCount:                int32(m["min_numeric_count"].(int)),
+			MinSpecialCharCount:            int32(m["min_special_char_count"].(int)),
+			MaxIdenticalAdjacentCharacters: int32(m["max_identical_adjacent_characters"].(int)),
+		},
+	}
+}
+
+func flattenSSOPasswordPolicy(policy *ssoadmin_types.AdminPasswordPolicy) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"description":                         policy.Description,
+			"password_lifetime_days":              policy.PasswordLifetimeDays,
+			"prohibited_previous_passwords_count": policy.ProhibitedPreviousPasswordsCount,
+			"min_length":                          policy.PasswordFormat.LengthRestriction.MinLength,
+			"max_length":                          policy.PasswordFormat.LengthRestriction.MaxLength,
+			"min_alphabetic_count":                policy.PasswordFormat.AlphabeticRestriction.MinAlphabeticCount,
+			"min_uppercase_count":                 policy.PasswordFormat.AlphabeticRestriction.MinUppercaseCount,
+			"min_lowercase_count":                 policy.PasswordFormat.AlphabeticRestriction.MinLowercaseCount,
+			"min_numeric_count":                   policy.PasswordFormat.MinNumericCount,
+			"min_special_char_count":              policy.PasswordFormat.MinSpecialCharCount,
+			"max_identical_adjacent_characters":   policy.PasswordFormat.MaxIdenticalAdjacentCharacters,
+		},
+	}
+}
+
+func expandSSOLockoutPolicy(m map[string]interface{}) ssoadmin_types.AdminLockoutPolicy {
+	return ssoadmin_types.AdminLockoutPolicy{
+		Description:              m["description"].(string),
+		MaxFailedAttempts:        int32(m["max_failed_attempts"].(int)),
+		FailedAttemptIntervalSec: int64(m["failed_attempt_interval"].(int)),
+		AutoUnlockIntervalSec:    int64(m["auto_unlock_interval"].(int)),
+	}
+}
+
+func flattenSSOLockoutPolicy(policy *ssoadmin_types.AdminLockoutPolicy) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"description":             policy.Description,
+			"max_failed_attempts":     policy.MaxFailedAttempts,
+			"failed_attempt_interval": policy.FailedAttemptIntervalSec,
+			"auto_unlock_interval":    policy.AutoUnlockIntervalSec,
+		},
+	}
+}
+
+// expandSSOTokenPolicy converts the seconds in config to the milliseconds used
+// by sso
+func expandSSOTokenPolicy(m map[string]interface{}) sso.TokenPolicy {
+	return sso.TokenPolicy{
+		MaxBearerTokenLifetime: int64(m["max_bearer_token_lifetime"].(int)) * 1000,
+		MaxHoKTokenLifetime:    int64(m["max_hok_token_lifetime"].(int)) * 1000,
+		ClockTolerance:         int64(m["clock_tolerance"].(int)) * 1000,
+		DelegationCount:        int32(m["delegation_count"].(int)),
+		RenewCount:             int32(m["renew_count"].(int)),
+	}
+}
+
+func flattenSSOTokenPolicy(policy *sso.TokenPolicy) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"max_bearer_token_lifetime": policy.MaxBearerTokenLifetime / 1000,
+			"max_hok_token_lifetime":    policy.MaxHoKTokenLifetime / 1000,
+			"clock_tolerance":           policy.ClockTolerance / 1000,
+			"delegation_count":          policy.DelegationCount,
+			"renew_count":               policy.RenewCount,
+		},
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	ssoPoliciesResourceName = "vsphere_sso_policies.policies"
+)
+
+func TestAccResourceVSphereSSOPolicies_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccSkipIfEsxi(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereSSOPoliciesConfig(12, 3, 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ssoPoliciesResourceName, "password_policy.0.min_length", "12"),
+					resource.TestCheckResourceAttr(ssoPoliciesResourceName, "lockout_policy.0.max_failed_attempts", "3"),
+					resource.TestCheckResourceAttr(ssoPoliciesResourceName, "token_policy.0.max_bearer_token_lifetime", "600"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereSSOPoliciesConfig(15, 5, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ssoPoliciesResourceName, "password_policy.0.min_length", "15"),
+					resource.TestCheckResourceAttr(ssoPoliciesResourceName, "lockout_policy.0.max_failed_attempts", "5"),
+					resource.TestCheckResourceAttr(ssoPoliciesResourceName, "token_policy.0.max_bearer_token_lifetime", "300"),
+				),
+			},
+			{
+				ResourceName:      ssoPoliciesResourceName,
+				Config:            testAccResourceVSphereSSOPoliciesConfig(15, 5, 300),
+				ImportState:       true,
+				ImportStateId:     vsphereSSOPoliciesID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereSSOPoliciesConfig(minLength, maxFailedAttempts, bearerLifetime int) string {
+	return fmt.Sprintf(`
+	resource "vsphere_sso_policies" "policies" {
+		password_policy {
+			min_length = %d
+		}
+
+		lockout_policy {
+			max_failed_attempts = %d
+		}
+
+		token_policy {
+			max_bearer_token_lifetime = %d
+		}
+	}
+	`,
+		minLength,
+		maxFailedAttempts,
+		bearerLifetime,
+	)
+}
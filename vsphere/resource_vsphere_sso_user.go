@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/sso"
+	"github.com/vmware/govmomi/ssoadmin"
+	ssoadmin_types "github.com/vmware/govmomi/ssoadmin/types"
+)
+
+func resourceVSphereSSOUser() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceVSphereSSOUserCreate,
+		Read:          resourceVSphereSSOUserRead,
+		Update:        resourceVSphereSSOUserUpdate,
+		Delete:        resourceVSphereSSOUserDelete,
+		CustomizeDiff: resourceVSphereSSOUserCustomDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereSSOUserImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the user in the sso system domain",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Password of the user.  The password is never read back from sso",
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "First name of the user",
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Last name of the user",
+			},
+			"email_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Email address of the user",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the user",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the user is enabled",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the user is locked by the lockout policy.  Set to false to unlock the user",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Sso system domain the user is in",
+			},
+		},
+	}
+}
+
+func resourceVSphereSSOUserCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).ssoClient
+	username := d.Get("username").(string)
+
+	log.Printf("[INFO] creating sso user '%s'", username)
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+	defer cancel()
+
+	if err := client.CreatePersonUser(ctx, username, expandSSOUserDetails(d), d.Get("password").(string)); err != nil {
+		return fmt.Errorf("error creating sso user '%s': %s", username, err)
+	}
+
+	d.SetId(username)
+
+	if !d.Get("enabled").(bool) {
+		if err := sso.SetUserEnabled(client, sso.PrincipalID(client, username), false); err != nil {
+			return err
+		}
+	}
+
+	return resourceVSphereSSOUserRead(d, meta)
+}
+
+func resourceVSphereSSOUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).ssoClient
+
+	user, err := vsphereSSOUserFind(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		log.Printf("[DEBUG] sso user '%s' not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("username", user.Id.Name)
+	d.Set("domain", user.Id.Domain)
+	d.Set("first_name", user.Details.FirstName)
+	d.Set("last_name", user.Details.LastName)
+	d.Set("email_address", user.Details.EmailAddress)
+	d.Set("description", user.Details.Description)
+	d.Set("enabled", !user.Disabled)
+	d.Set("locked", user.Locked)
+	return nil
+}
+
+func resourceVSphereSSOUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).ssoClient
+	id := sso.PrincipalID(client, d.Id())
+
+	log.Printf("[INFO] updating sso user '%s'", d.Id())
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+	defer cancel()
+
+	if d.HasChanges("first_name", "last_name", "email_address", "description") {
+		if err := client.UpdatePersonUser(ctx, d.Id(), expandSSOUserDetails(d)); err != nil {
+			return fmt.Errorf("error updating sso user '%s': %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("password") {
+		if err := client.ResetPersonPassword(ctx, d.Id(), d.Get("password").(string)); err != nil {
+			return fmt.Errorf("error setting password of sso user '%s': %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled") {
+		if err := sso.SetUserEnabled(client, id, d.Get("enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("locked") && !d.Get("locked").(bool) {
+		if err := sso.UnlockUser(client, id); err != nil {
+			return err
+		}
+	}
+
+	return resourceVSphereSSOUserRead(d, meta)
+}
+
+func resourceVSphereSSOUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).ssoClient
+
+	log.Printf("[INFO] deleting sso user '%s'", d.Id())
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+	defer cancel()
+
+	if err := client.DeletePrincipal(ctx, d.Id()); err != nil {
+		return fmt.Errorf("error deleting sso user '%s': %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceVSphereSSOUserImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client).ssoClient
+	if err := sso.CheckClient(client); err != nil {
+		return nil, err
+	}
+
+	user, err := vsphereSSOUserFind(client, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, fmt.Errorf("sso user '%s' does not exist", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVSphereSSOUserCustomDiff(ctx context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*Client).ssoClient
+	if err := sso.CheckClient(client); err != nil {
+		return err
+	}
+
+	// Users can only be locked by failed logins so only allow unlocking them
+	if rd.HasChange("locked") && rd.Get("locked").(bool) {
+		return fmt.Errorf("sso users can't be locked, 'locked' can only be set to false to unlock a user")
+	}
+
+	if rd.Id() == "" {
+		user, err := vsphereSSOUserFind(client, rd.Get("username").(string))
+		if err != nil {
+			return err
+		}
+
+		if user != nil {
+			return fmt.Errorf("sso user '%s' already exists - consider running a 'terraform import'", rd.Get("username").(string))
+		}
+	}
+
+	return nil
+}
+
+func vsphereSSOUserFind(client *ssoadmin.Client, username string) (*ssoadmin_types.AdminPersonUser, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+	defer cancel()
+
+	user, err := client.FindPersonUser(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving sso user '%s': %s", username, err)
+	}
+
+	return user, nil
+}
+
+func expandSSOUserDetails(d *schema.ResourceData) ssoadmin_types.AdminPersonDetails {
+	return ssoadmin_types.AdminPersonDetails{
+		FirstName:    d.Get("first_name").(string),
+		LastName:     d.Get("last_name").(string),
+		EmailAddress: d.Get("email_address").(string),
+		Description:  d.Get("description").(string),
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	ssoUserResourceName = "vsphere_sso_user.u1"
+)
+
+func TestAccResourceVSphereSSOUser_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccSkipIfEsxi(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereSSOUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereSSOUserConfig("Terraform", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ssoUserResourceName, "first_name", "Terraform"),
+					resource.TestCheckResourceAttr(ssoUserResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(ssoUserResourceName, "locked", "false"),
+					resource.TestCheckResourceAttrSet(ssoUserResourceName, "domain"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereSSOUserConfig("Acceptance", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ssoUserResourceName, "first_name", "Acceptance"),
+					resource.TestCheckResourceAttr(ssoUserResourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            ssoUserResourceName,
+				Config:                  testAccResourceVSphereSSOUserConfig("Acceptance", false),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereSSOUserDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vsphere_sso_user" {
+			continue
+		}
+
+		user, err := testAccProvider.Meta().(*Client).ssoClient.FindPersonUser(context.Background(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if user != nil {
+			return fmt.Errorf("sso user '%s' still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccResourceVSphereSSOUserConfig(firstName string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "vsphere_sso_user" "u1" {
+		username   = "tf-acc-test-user"
+		password   = "Tf-Acc-Test-Pa55!"
+		first_name = "%s"
+		last_name  = "User"
+		enabled    = %t
+	}
+	`,
+		firstName,
+		enabled,
+	)
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/govmomi/vapi/appliance/access/consolecli"
+	"github.com/vmware/govmomi/vapi/appliance/access/dcui"
+	"github.com/vmware/govmomi/vapi/appliance/access/shell"
+	"github.com/vmware/govmomi/vapi/appliance/access/ssh"
+)
+
+const (
+	vsphereVcenterAccessID = "tf-vcenter-access"
+)
+
+func resourceVSphereVcenterAccess() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceVSphereVcenterAccessCreate,
+		Read:          resourceVSphereVcenterAccessRead,
+		Update:        resourceVSphereVcenterAccessUpdate,
+		Delete:        resourceVSphereVcenterAccessDelete,
+		CustomizeDiff: resourceVSphereVcenterAccessCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceVSphereVcenterAccessImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ssh_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables ssh access to the vcenter appliance",
+			},
+			"dcui_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables direct console user interface access to the vcenter appliance",
+			},
+			"console_cli_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enables console based controlled cli access to the vcenter appliance",
+			},
+			"shell_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables bash shell access to the vcenter appliance",
+			},
+			"shell_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Number in seconds the bash shell stays enabled for.  Can only be set when shell_enabled is set",
+				ValidateFunc: validation.IntBetween(0, 86400),
+			},
+		},
+	}
+}
+
+func resourceVSphereVcenterAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterAccessUpdate(d, meta)
+	if err != nil {
+		return fmt.Errorf("error creating access configurations: %s", err)
+	}
+
+	d.SetId(vsphereVcenterAccessID)
+	return nil
+}
+
+func resourceVSphereVcenterAccessRead(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterAccessRead(d, meta)
+	if err != nil {
+		return fmt.Errorf("error retrieving access configuration info in read function: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterAccessUpdate(d, meta)
+	if err != nil {
+		return fmt.Errorf("error updating access configurations: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVSphereVcenterAccessDelete only removes the resource from state as
+// there are no defaults we can safely revert access settings back to without
+// possibly locking users out of the appliance
+func resourceVSphereVcenterAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceVSphereVcenterAccessImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != vsphereVcenterAccessID {
+		return nil, fmt.Errorf("invalid import.  Import should simply be '%s'", vsphereVcenterAccessID)
+	}
+
+	err := vsphereVcenterAccessRead(d, meta)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving access configuration info in import function: %s", err)
+	}
+
+	// The timeout isn't read back from the appliance so start from its default
+	d.Set("shell_timeout", 0)
+	d.SetId(vsphereVcenterAccessID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceVSphereVcenterAccessCustomizeDiff rejects a shell timeout for a
+// disabled shell, as the appliance reports a timeout of 0 while disabled
+func resourceVSphereVcenterAccessCustomizeDiff(ctx context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+	if !rd.Get("shell_enabled").(bool) && rd.Get("shell_timeout").(int) != 0 {
+		return fmt.Errorf("shell_timeout can only be set when shell_enabled is true")
+	}
+
+	return nil
+}
+
+func vsphereVcenterAccessRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+	defer cancel()
+
+	client := meta.(*Client).restClient
+
+	sshEnabled, err := ssh.NewManager(client).Get(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving ssh access: %s", err)
+	}
+
+	dcuiEnabled, err := dcui.NewManager(client).Get(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving dcui access: %s", err)
+	}
+
+	consoleCliEnabled, err := consolecli.NewManager(client).Get(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving console cli access: %s", err)
+	}
+
+	shellAccess, err := shell.NewManager(client).Get(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving shell access: %s", err)
+	}
+
+	d.Set("ssh_enabled", sshEnabled)
+	d.Set("dcui_enabled", dcuiEnabled)
+	d.Set("console_cli_enabled", consoleCliEnabled)
+	d.Set("shell_enabled", shellAccess.Enabled)
+
+	// The timeout counts down once the shell is enabled and is reported as 0
+	// while disabled, so it is never read back as it would always produce a
+	// diff
+
+	return nil
+}
+
+func vsphereVcenterAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+	defer cancel()
+
+	client := meta.(*Client).restClient
+
+	if d.IsNewResource() || d.HasChange("ssh_enabled") {
+		if err := ssh.NewManager(client).Set(ctx, ssh.Access{
+			Enabled: d.Get("ssh_enabled").(bool),
+		}); err != nil {
+			return fmt.Errorf("error setting ssh access: %s", err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("dcui_enabled") {
+		if err := dcui.NewManager(client).Set(ctx, dcui.Access{
+			Enabled: d.Get("dcui_enabled").(bool),
+		}); err != nil {
+			return fmt.Errorf("error setting dcui access: %s", err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("console_cli_enabled") {
+		if err := consolecli.NewManager(client).Set(ctx, consolecli.Access{
+			Enabled: d.Get("console_cli_enabled").(bool),
+		}); err != nil {
+			return fmt.Errorf("error setting console cli access: %s", err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChanges("shell_enabled", "shell_timeout") {
+		if err := shell.NewManager(client).Set(ctx, shell.Access{
+			Enabled: d.Get("shell_enabled").(bool),
+			Timeout: d.Get("shell_timeout").(int),
+		}); err != nil {
+			return fmt.Errorf("error setting shell access: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/govmomi/vapi/appliance/access/shell"
+	"github.com/vmware/govmomi/vapi/appliance/access/ssh"
+)
+
+func TestAccResourceVSphereVcenterAccess_basic(t *testing.T) {
+	resourceName := "vsphere_vcenter_access.access"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceVSphereVcenterAccessShellTimeoutConfig(),
+				ExpectError: regexp.MustCompile("shell_timeout can only be set when shell_enabled is true"),
+			},
+			{
+				Config: testAccResourceVSphereVcenterAccessConfig(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereVcenterAccessValidation(resourceName, true, false),
+				),
+			},
+			{
+				Config: testAccResourceVSphereVcenterAccessConfig(false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereVcenterAccessValidation(resourceName, false, true),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				Config:                  testAccResourceVSphereVcenterAccessConfig(false, true),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"shell_timeout"},
+			},
+		},
+	})
+}
+
+func testAccVSphereVcenterAccessValidation(resourceName string, sshEnabled, shellEnabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s key not found on the server", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*Client).restClient
+
+		sshState, err := ssh.NewManager(client).Get(context.Background())
+		if err != nil {
+			return err
+		}
+
+		if sshState != sshEnabled {
+			return fmt.Errorf("ssh access should be %t; got %t", sshEnabled, sshState)
+		}
+
+		shellState, err := shell.NewManager(client).Get(context.Background())
+		if err != nil {
+			return err
+		}
+
+		if shellState.Enabled != shellEnabled {
+			return fmt.Errorf("shell access should be %t; got %t", shellEnabled, shellState.Enabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereVcenterAccessConfig(sshEnabled, shellEnabled bool) string {
+	shellTimeout := 0
+	if shellEnabled {
+		shellTimeout = 3600
+	}
+
+	return fmt.Sprintf(`
+	resource "vsphere_vcenter_access" "access" {
+		ssh_enabled   = %t
+		shell_enabled = %t
+		shell_timeout = %d
+	}
+	`,
+		sshEnabled,
+		shellEnabled,
+		shellTimeout,
+	)
+}
+
+func testAccResourceVSphereVcenterAccessShellTimeoutConfig() string {
+	return `
+	resource "vsphere_vcenter_access" "access" {
+		shell_enabled = false
+		shell_timeout = 3600
+	}
+	`
+}
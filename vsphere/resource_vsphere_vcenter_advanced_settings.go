@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostconfig"
+)
+
+const (
+	vsphereVcenterAdvancedSettingsID = "tf-vcenter-advanced-settings"
+)
+
+func resourceVSphereVcenterAdvancedSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereVcenterAdvancedSettingsCreate,
+		Read:   resourceVSphereVcenterAdvancedSettingsRead,
+		Update: resourceVSphereVcenterAdvancedSettingsUpdate,
+		Delete: resourceVSphereVcenterAdvancedSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereVcenterAdvancedSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"settings": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Description: "Map of vcenter advanced setting keys to their values.  Values are converted to the type vcenter reports for the key",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceVSphereVcenterAdvancedSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] creating vcenter advanced settings")
+
+	if err := vsphereVcenterAdvancedSettingsUpdate(meta, d.Get("settings").(map[string]interface{})); err != nil {
+		return fmt.Errorf("error creating vcenter advanced settings: %s", err)
+	}
+
+	d.SetId(vsphereVcenterAdvancedSettingsID)
+	return resourceVSphereVcenterAdvancedSettingsRead(d, meta)
+}
+
+func resourceVSphereVcenterAdvancedSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	keys := make([]string, 0)
+	for key := range d.Get("settings").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+
+	settings, err := vsphereVcenterAdvancedSettingsRead(meta, keys)
+	if err != nil {
+		return fmt.Errorf("error retrieving vcenter advanced settings in read function: %s", err)
+	}
+
+	d.Set("settings", settings)
+	return nil
+}
+
+func resourceVSphereVcenterAdvancedSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] updating vcenter advanced settings")
+
+	oldVal, newVal := d.GetChange("settings")
+	oldSettings := oldVal.(map[string]interface{})
+	changed := make(map[string]interface{})
+
+	// Only send keys that were added or changed.  Keys removed from config are
+	// left as is on vcenter and are simply no longer managed
+	for key, value := range newVal.(map[string]interface{}) {
+		if old, ok := oldSettings[key]; !ok || old != value {
+			changed[key] = value
+		}
+	}
+
+	if err := vsphereVcenterAdvancedSettingsUpdate(meta, changed); err != nil {
+		return fmt.Errorf("error updating vcenter advanced settings: %s", err)
+	}
+
+	return resourceVSphereVcenterAdvancedSettingsRead(d, meta)
+}
+
+// resourceVSphereVcenterAdvancedSettingsDelete only removes the resource from
+// state as vcenter does not report defaults for its advanced settings
+func resourceVSphereVcenterAdvancedSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] removing vcenter advanced settings from state")
+	return nil
+}
+
+func resourceVSphereVcenterAdvancedSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keys := strings.Split(d.Id(), ",")
+
+	settings, err := vsphereVcenterAdvancedSettingsRead(meta, keys)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving vcenter advanced settings in import function: %s", err)
+	}
+
+	for _, key := range keys {
+		if _, ok := settings[key]; !ok {
+			return nil, fmt.Errorf("vcenter advanced setting '%s' does not exist", key)
+		}
+	}
+
+	d.SetId(vsphereVcenterAdvancedSettingsID)
+	d.Set("settings", settings)
+	return []*schema.ResourceData{d}, nil
+}
+
+// vsphereVcenterAdvancedSettingsRead reads back only the given keys from the
+// vcenter option manager formatted as strings
+func vsphereVcenterAdvancedSettingsRead(meta interface{}, keys []string) (map[string]interface{}, error) {
+	optManager, err := hostconfig.GetVcenterOptionManager(meta.(*Client).vimClient)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := hostconfig.QueryOptionValues(optManager, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		if value, ok := values[key]; ok {
+			settings[key] = hostconfig.OptionValueString(value)
+		}
+	}
+
+	return settings, nil
+}
+
+func vsphereVcenterAdvancedSettingsUpdate(meta interface{}, settings map[string]interface{}) error {
+	optManager, err := hostconfig.GetVcenterOptionManager(meta.(*Client).vimClient)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(settings))
+	values := make(map[string]string, len(settings))
+
+	for key, value := range settings {
+		keys = append(keys, key)
+		values[key] = value.(string)
+	}
+
+	current, err := hostconfig.QueryOptionValues(optManager, keys)
+	if err != nil {
+		return err
+	}
+
+	return hostconfig.UpdateOptionValues(optManager, current, values)
+}
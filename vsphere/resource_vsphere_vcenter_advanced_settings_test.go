@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	vcenterAdvancedSettingsResourceName = "vsphere_vcenter_advanced_settings.settings"
+	vcenterAdvancedSettingsTaskKey      = "task.maxAge"
+)
+
+func TestAccResourceVSphereVcenterAdvancedSettings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereVcenterAdvancedSettingsConfig("60"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceVSphereVcenterAdvancedSettingsValidate(vcenterAdvancedSettingsTaskKey, "60"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereVcenterAdvancedSettingsConfig("30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceVSphereVcenterAdvancedSettingsValidate(vcenterAdvancedSettingsTaskKey, "30"),
+				),
+			},
+			{
+				ResourceName:      vcenterAdvancedSettingsResourceName,
+				Config:            testAccResourceVSphereVcenterAdvancedSettingsConfig("30"),
+				ImportState:       true,
+				ImportStateId:     vcenterAdvancedSettingsTaskKey,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereVcenterAdvancedSettingsValidate(key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		settings, err := vsphereVcenterAdvancedSettingsRead(testAccProvider.Meta(), []string{key})
+		if err != nil {
+			return err
+		}
+
+		if settings[key] != value {
+			return fmt.Errorf("setting '%s' should be '%s'; got '%v'", key, value, settings[key])
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereVcenterAdvancedSettingsConfig(value string) string {
+	return fmt.Sprintf(`
+	resource "vsphere_vcenter_advanced_settings" "settings" {
+		settings = {
+			"%s" = "%s"
+		}
+	}
+	`,
+		vcenterAdvancedSettingsTaskKey,
+		value,
+	)
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+	"github.com/vmware/govmomi"
+)
+
+const (
+	vsphereVcenterFirewallRulesID = "tf-vcenter-firewall-rules"
+
+	firewallInboundPath = "/appliance/networking/firewall/inbound"
+
+	// firewallSourceDialTimeout is how long to wait when dialing vcenter to
+	// figure out the source address the provider connects from
+	firewallSourceDialTimeout = 10 * time.Second
+)
+
+func resourceVSphereVcenterFirewallRules() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceVSphereVcenterFirewallRulesCreate,
+		Read:          resourceVSphereVcenterFirewallRulesRead,
+		Update:        resourceVSphereVcenterFirewallRulesUpdate,
+		Delete:        resourceVSphereVcenterFirewallRulesDelete,
+		CustomizeDiff: resourceVSphereVcenterFirewallRulesCustomDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceVSphereVcenterFirewallRulesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"skip_lockout_check": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, will skip checking whether the rules would block the address the provider connects from",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Ordered list of inbound firewall rules.  The first rule that matches an address is applied",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "IPv4 or IPv6 address the rule matches",
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"prefix": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "CIDR prefix used to mask the address",
+							ValidateFunc: validation.IntBetween(0, 128),
+						},
+						"policy": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Policy applied to matching traffic",
+							ValidateFunc: validation.StringInSlice(
+								[]string{"IGNORE", "ACCEPT", "REJECT", "RETURN"},
+								false,
+							),
+						},
+						"interface_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "*",
+							Description: "Interface the rule applies to.  '*' applies to all interfaces",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVSphereVcenterFirewallRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterFirewallRulesUpdate(d, meta, false)
+	if err != nil {
+		return fmt.Errorf("error creating firewall rules: %s", err)
+	}
+
+	d.SetId(vsphereVcenterFirewallRulesID)
+	return nil
+}
+
+func resourceVSphereVcenterFirewallRulesRead(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterFirewallRulesRead(d, meta)
+	if err != nil {
+		return fmt.Errorf("error retrieving firewall rules in read function: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterFirewallRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterFirewallRulesUpdate(d, meta, false)
+	if err != nil {
+		return fmt.Errorf("error updating firewall rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterFirewallRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterFirewallRulesUpdate(d, meta, true)
+	if err != nil {
+		return fmt.Errorf("error deleting firewall rules: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterFirewallRulesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != vsphereVcenterFirewallRulesID {
+		return nil, fmt.Errorf("invalid import.  Import should simply be '%s'", vsphereVcenterFirewallRulesID)
+	}
+
+	err := vsphereVcenterFirewallRulesRead(d, meta)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving firewall rules in import function: %s", err)
+	}
+
+	d.SetId(vsphereVcenterFirewallRulesID)
+	d.Set("skip_lockout_check", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceVSphereVcenterFirewallRulesCustomDiff guards against applying rules
+// that would block the address the provider itself connects to vcenter from
+func resourceVSphereVcenterFirewallRulesCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("skip_lockout_check").(bool) || !d.NewValueKnown("rule") {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChange("rule") {
+		return nil
+	}
+
+	sourceIP, err := vsphereVcenterFirewallSourceAddress(meta.(*Client).vimClient)
+	if err != nil {
+		return err
+	}
+
+	for i, r := range d.Get("rule").([]interface{}) {
+		rule := r.(map[string]interface{})
+
+		_, ipNet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", rule["address"], rule["prefix"]))
+		if err != nil {
+			return fmt.Errorf("invalid address and prefix for rule %d: %s", i, err)
+		}
+
+		if !ipNet.Contains(sourceIP) {
+			continue
+		}
+
+		// The first matching rule wins so only rules that drop the traffic
+		// would lock the provider out
+		switch rule["policy"].(string) {
+		case "IGNORE", "REJECT":
+			return fmt.Errorf(
+				"rule %d (%s) would block the provider's source address '%s' from reaching vcenter.  Set 'skip_lockout_check' to override",
+				i,
+				ipNet.String(),
+				sourceIP.String(),
+			)
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// vsphereVcenterFirewallSourceAddress returns the local address used when
+// connecting to vcenter which is the address vcenter sees the provider as
+func vsphereVcenterFirewallSourceAddress(client *govmomi.Client) (net.IP, error) {
+	u := client.URL()
+	port := u.Port()
+
+	if port == "" {
+		port = "443"
+	}
+
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(u.Hostname(), port), firewallSourceDialTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("error determining source address of provider: %s", err)
+	}
+	defer conn.Close()
+
+	addr := conn.LocalAddr().(*net.TCPAddr)
+	log.Printf("[DEBUG] provider connects to vcenter from '%s'", addr.IP.String())
+	return addr.IP, nil
+}
+
+func vsphereVcenterFirewallRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).restClient
+
+	rules, err := viapi.RestRequest[[]interface{}](client, http.MethodGet, firewallInboundPath, nil)
+	if err != nil {
+		return fmt.Errorf("error retrieving firewall rules: %s", err)
+	}
+
+	ruleList := make([]interface{}, 0, len(rules))
+
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		ruleList = append(ruleList, map[string]interface{}{
+			"address":        rule["address"],
+			"prefix":         rule["prefix"],
+			"policy":         rule["policy"],
+			"interface_name": rule["interface_name"],
+		})
+	}
+
+	d.Set("rule", ruleList)
+	return nil
+}
+
+func vsphereVcenterFirewallRulesUpdate(d *schema.ResourceData, meta interface{}, isDelete bool) error {
+	client := meta.(*Client).restClient
+	rules := []interface{}{}
+
+	if !isDelete {
+		rules = d.Get("rule").([]interface{})
+	}
+
+	if _, err := viapi.RestRequest[[]interface{}](
+		client,
+		http.MethodPut,
+		firewallInboundPath,
+		map[string]interface{}{
+			"rules": rules,
+		},
+	); err != nil {
+		return fmt.Errorf("error on firewall rules update request: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+)
+
+func TestAccResourceVSphereVcenterFirewallRules_basic(t *testing.T) {
+	resourceName := "vsphere_vcenter_firewall_rules.rules"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVSphereVcenterFirewallRulesValidation(0),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereVcenterFirewallRulesConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereVcenterFirewallRulesValidation(1),
+				),
+			},
+			{
+				Config: testAccResourceVSphereVcenterFirewallRulesConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereVcenterFirewallRulesValidation(2),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.address", "192.0.2.20"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.address", "192.0.2.10"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				Config:                  testAccResourceVSphereVcenterFirewallRulesConfig(true),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_lockout_check"},
+			},
+		},
+	})
+}
+
+func TestAccResourceVSphereVcenterFirewallRules_lockout(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "vsphere_vcenter_firewall_rules" "rules" {
+					rule {
+						address = "0.0.0.0"
+						prefix  = 0
+						policy  = "REJECT"
+					}
+				}
+				`,
+				ExpectError: regexp.MustCompile("would block the provider's source address"),
+			},
+		},
+	})
+}
+
+func testAccVSphereVcenterFirewallRulesValidation(count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client).restClient
+
+		rules, err := viapi.RestRequest[[]interface{}](client, http.MethodGet, firewallInboundPath, nil)
+		if err != nil {
+			return err
+		}
+
+		if len(rules) != count {
+			return fmt.Errorf("should have %d firewall rules; got %d", count, len(rules))
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereVcenterFirewallRulesConfig(reordered bool) string {
+	if !reordered {
+		return `
+		resource "vsphere_vcenter_firewall_rules" "rules" {
+			rule {
+				address = "192.0.2.10"
+				prefix  = 32
+				policy  = "REJECT"
+			}
+		}
+		`
+	}
+
+	return `
+	resource "vsphere_vcenter_firewall_rules" "rules" {
+		rule {
+			address = "192.0.2.20"
+			prefix  = 32
+			policy  = "IGNORE"
+		}
+		rule {
+			address = "192.0.2.10"
+			prefix  = 32
+			policy  = "REJECT"
+		}
+	}
+	`
+}
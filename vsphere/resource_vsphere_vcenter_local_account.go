@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+)
+
+const (
+	localAccountsPath = "/appliance/local-accounts"
+
+	// vcenterRootAccount is the built in appliance account which can't be
+	// removed so it is only ever updated
+	vcenterRootAccount = "root"
+)
+
+func resourceVSphereVcenterLocalAccount() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceVSphereVcenterLocalAccountCreate,
+		Read:          resourceVSphereVcenterLocalAccountRead,
+		Update:        resourceVSphereVcenterLocalAccountUpdate,
+		Delete:        resourceVSphereVcenterLocalAccountDelete,
+		CustomizeDiff: resourceVSphereVcenterLocalAccountCustomDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereVcenterLocalAccountImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the local account",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the local account.  Required when creating a new account",
+			},
+			"full_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full name of the user",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Email address of the user",
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Roles of the local account",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice(
+						[]string{"superAdmin", "admin", "operator"},
+						false,
+					),
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the local account is enabled",
+			},
+			"password_expires": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the password of the local account expires",
+			},
+			"max_days_without_password_change": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Maximum number of days the password can be used before it must be changed",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_days_between_password_change": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Minimum number of days between password changes",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"warn_days_before_password_expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Number of days of warning before the password expires",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"has_password": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the local account has a password set",
+			},
+			"last_password_change": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of the last password change",
+			},
+			"password_expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time the password expires",
+			},
+		},
+	}
+}
+
+func resourceVSphereVcenterLocalAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).restClient
+	username := d.Get("username").(string)
+
+	exists, err := vsphereVcenterLocalAccountExists(meta, username)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("local account '%s' already exists - consider running a 'terraform import'", username)
+	}
+
+	if d.Get("password").(string) == "" {
+		return fmt.Errorf("password is required when creating local account '%s'", username)
+	}
+
+	log.Printf("[INFO] creating vcenter local account '%s'", username)
+
+	if _, err = viapi.RestRequest[[]interface{}](
+		client,
+		http.MethodPost,
+		localAccountsPath+"/"+username,
+		map[string]interface{}{
+			"config": vsphereVcenterLocalAccountConfig(d, true),
+		},
+	); err != nil {
+		return fmt.Errorf("error creating local account '%s': %s", username, err)
+	}
+
+	d.SetId(username)
+	return resourceVSphereVcenterLocalAccountRead(d, meta)
+}
+
+func resourceVSphereVcenterLocalAccountRead(d *schema.ResourceData, meta interface{}) error {
+	exists, err := vsphereVcenterLocalAccountExists(meta, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		log.Printf("[DEBUG] local account '%s' not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return vsphereVcenterLocalAccountRead(d, meta, d.Id())
+}
+
+func resourceVSphereVcenterLocalAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).restClient
+
+	log.Printf("[INFO] updating vcenter local account '%s'", d.Id())
+
+	if _, err := viapi.RestRequest[[]interface{}](
+		client,
+		http.MethodPatch,
+		localAccountsPath+"/"+d.Id(),
+		map[string]interface{}{
+			"config": vsphereVcenterLocalAccountConfig(d, d.HasChange("password")),
+		},
+	); err != nil {
+		return fmt.Errorf("error updating local account '%s': %s", d.Id(), err)
+	}
+
+	return resourceVSphereVcenterLocalAccountRead(d, meta)
+}
+
+func resourceVSphereVcenterLocalAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	if d.Id() == vcenterRootAccount {
+		log.Printf("[INFO] local account '%s' can't be deleted, only removing from state", d.Id())
+		return nil
+	}
+
+	client := meta.(*Client).restClient
+
+	log.Printf("[INFO] deleting vcenter local account '%s'", d.Id())
+
+	if _, err := viapi.RestRequest[[]interface{}](
+		client,
+		http.MethodDelete,
+		localAccountsPath+"/"+d.Id(),
+		nil,
+	); err != nil {
+		return fmt.Errorf("error deleting local account '%s': %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterLocalAccountImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	exists, err := vsphereVcenterLocalAccountExists(meta, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("local account '%s' does not exist", d.Id())
+	}
+
+	if err = vsphereVcenterLocalAccountRead(d, meta, d.Id()); err != nil {
+		return nil, err
+	}
+
+	d.Set("username", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceVSphereVcenterLocalAccountCustomDiff catches accounts that already
+// exist or are missing a password during plan instead of failing on apply
+func resourceVSphereVcenterLocalAccountCustomDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	username := d.Get("username").(string)
+
+	if d.Get("password").(string) == "" && d.NewValueKnown("password") {
+		return fmt.Errorf("password is required when creating local account '%s'", username)
+	}
+
+	exists, err := vsphereVcenterLocalAccountExists(meta, username)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("local account '%s' already exists - consider running a 'terraform import'", username)
+	}
+
+	return nil
+}
+
+func vsphereVcenterLocalAccountExists(meta interface{}, username string) (bool, error) {
+	client := meta.(*Client).restClient
+
+	accounts, err := viapi.RestRequest[[]interface{}](client, http.MethodGet, localAccountsPath, nil)
+	if err != nil {
+		return false, fmt.Errorf("error retrieving local accounts: %s", err)
+	}
+
+	for _, account := range accounts {
+		if account.(string) == username {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func vsphereVcenterLocalAccountRead(d *schema.ResourceData, meta interface{}, username string) error {
+	client := meta.(*Client).restClient
+
+	valRes, err := viapi.RestRequest[map[string]interface{}](
+		client,
+		http.MethodGet,
+		localAccountsPath+"/"+username,
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("error retrieving local account '%s': %s", username, err)
+	}
+
+	// The api only returns an expiry date when the password can expire
+	_, passwordExpires := valRes["password_expires_at"]
+
+	d.Set("full_name", valRes["fullname"])
+	d.Set("email", valRes["email"])
+	d.Set("roles", valRes["roles"])
+	d.Set("enabled", valRes["enabled"])
+	d.Set("has_password", valRes["has_password"])
+	d.Set("password_expires", passwordExpires)
+	d.Set("password_expires_at", valRes["password_expires_at"])
+	d.Set("last_password_change", valRes["last_password_change"])
+	d.Set("max_days_without_password_change", valRes["max_days_without_password_change"])
+	d.Set("min_days_between_password_change", valRes["min_days_between_password_change"])
+	d.Set("warn_days_before_password_expiration", valRes["warn_days_before_password_expiration"])
+	return nil
+}
+
+// vsphereVcenterLocalAccountConfig builds the config payload for a local
+// account.  The password is write only and is only sent when it is being set
+// to a non-empty value so removing it from config keeps the current password
+func vsphereVcenterLocalAccountConfig(d *schema.ResourceData, withPassword bool) map[string]interface{} {
+	config := map[string]interface{}{
+		"fullname":         d.Get("full_name").(string),
+		"email":            d.Get("email").(string),
+		"roles":            d.Get("roles").(*schema.Set).List(),
+		"enabled":          d.Get("enabled").(bool),
+		"password_expires": d.Get("password_expires").(bool),
+	}
+
+	if password := d.Get("password").(string); withPassword && password != "" {
+		config["password"] = password
+	}
+
+	for _, key := range []string{
+		"max_days_without_password_change",
+		"min_days_between_password_change",
+		"warn_days_before_password_expiration",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			config[key] = v.(int)
+		}
+	}
+
+	return config
+}
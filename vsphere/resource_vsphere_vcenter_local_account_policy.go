@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+)
+
+const (
+	vsphereVcenterLocalAccountPolicyID = "tf-vcenter-local-account-policy"
+
+	localAccountsGlobalPolicyPath = "/appliance/local-accounts/global-policy"
+
+	// Default global password policy values of a newly deployed appliance
+	localAccountsDefaultMaxDays  = 90
+	localAccountsDefaultMinDays  = 0
+	localAccountsDefaultWarnDays = 7
+)
+
+func resourceVSphereVcenterLocalAccountPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereVcenterLocalAccountPolicyCreate,
+		Read:   resourceVSphereVcenterLocalAccountPolicyRead,
+		Update: resourceVSphereVcenterLocalAccountPolicyUpdate,
+		Delete: resourceVSphereVcenterLocalAccountPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVSphereVcenterLocalAccountPolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"max_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      localAccountsDefaultMaxDays,
+				Description:  "Maximum number of days a password can be used before it must be changed",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      localAccountsDefaultMinDays,
+				Description:  "Minimum number of days between password changes",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"warn_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      localAccountsDefaultWarnDays,
+				Description:  "Number of days of warning before a password expires",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceVSphereVcenterLocalAccountPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterLocalAccountPolicyUpdate(d, meta, false)
+	if err != nil {
+		return fmt.Errorf("error creating local account policy: %s", err)
+	}
+
+	d.SetId(vsphereVcenterLocalAccountPolicyID)
+	return nil
+}
+
+func resourceVSphereVcenterLocalAccountPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterLocalAccountPolicyRead(d, meta)
+	if err != nil {
+		return fmt.Errorf("error retrieving local account policy in read function: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterLocalAccountPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterLocalAccountPolicyUpdate(d, meta, false)
+	if err != nil {
+		return fmt.Errorf("error updating local account policy: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterLocalAccountPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterLocalAccountPolicyUpdate(d, meta, true)
+	if err != nil {
+		return fmt.Errorf("error deleting local account policy: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterLocalAccountPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != vsphereVcenterLocalAccountPolicyID {
+		return nil, fmt.Errorf("invalid import.  Import should simply be '%s'", vsphereVcenterLocalAccountPolicyID)
+	}
+
+	err := vsphereVcenterLocalAccountPolicyRead(d, meta)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving local account policy in import function: %s", err)
+	}
+
+	d.SetId(vsphereVcenterLocalAccountPolicyID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func vsphereVcenterLocalAccountPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).restClient
+
+	valRes, err := viapi.RestRequest[map[string]interface{}](client, http.MethodGet, localAccountsGlobalPolicyPath, nil)
+	if err != nil {
+		return fmt.Errorf("error retrieving global policy: %s", err)
+	}
+
+	d.Set("max_days", valRes["max_days"])
+	d.Set("min_days", valRes["min_days"])
+	d.Set("warn_days", valRes["warn_days"])
+	return nil
+}
+
+func vsphereVcenterLocalAccountPolicyUpdate(d *schema.ResourceData, meta interface{}, isDelete bool) error {
+	client := meta.(*Client).restClient
+
+	policy := map[string]interface{}{
+		"max_days":  localAccountsDefaultMaxDays,
+		"min_days":  localAccountsDefaultMinDays,
+		"warn_days": localAccountsDefaultWarnDays,
+	}
+
+	if !isDelete {
+		policy["max_days"] = d.Get("max_days").(int)
+		policy["min_days"] = d.Get("min_days").(int)
+		policy["warn_days"] = d.Get("warn_days").(int)
+	}
+
+	if _, err := viapi.RestRequest[[]interface{}](
+		client,
+		http.MethodPut,
+		localAccountsGlobalPolicyPath,
+		map[string]interface{}{
+			"policy": policy,
+		},
+	); err != nil {
+		return fmt.Errorf("error on global policy update request: %s", err)
+	}
+
+	return nil
+}
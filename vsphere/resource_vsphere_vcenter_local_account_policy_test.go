@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+)
+
+func TestAccResourceVSphereVcenterLocalAccountPolicy_basic(t *testing.T) {
+	resourceName := "vsphere_vcenter_local_account_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVSphereVcenterLocalAccountPolicyValidation(localAccountsDefaultMaxDays, localAccountsDefaultWarnDays),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereVcenterLocalAccountPolicyConfig(120, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereVcenterLocalAccountPolicyValidation(120, 14),
+				),
+			},
+			{
+				Config: testAccResourceVSphereVcenterLocalAccountPolicyConfig(60, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereVcenterLocalAccountPolicyValidation(60, 10),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				Config:            testAccResourceVSphereVcenterLocalAccountPolicyConfig(60, 10),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVSphereVcenterLocalAccountPolicyValidation(maxDays, warnDays int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client).restClient
+
+		valRes, err := viapi.RestRequest[map[string]interface{}](client, http.MethodGet, localAccountsGlobalPolicyPath, nil)
+		if err != nil {
+			return err
+		}
+
+		if int(valRes["max_days"].(float64)) != maxDays {
+			return fmt.Errorf("max_days should be %d; got %v", maxDays, valRes["max_days"])
+		}
+
+		if int(valRes["warn_days"].(float64)) != warnDays {
+			return fmt.Errorf("warn_days should be %d; got %v", warnDays, valRes["warn_days"])
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereVcenterLocalAccountPolicyConfig(maxDays, warnDays int) string {
+	return fmt.Sprintf(`
+	resource "vsphere_vcenter_local_account_policy" "policy" {
+		max_days  = %d
+		min_days  = 1
+		warn_days = %d
+	}
+	`,
+		maxDays,
+		warnDays,
+	)
+}
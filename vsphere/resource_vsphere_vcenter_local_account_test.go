@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	vcenterLocalAccountResourceName = "vsphere_vcenter_local_account.account"
+	vcenterLocalAccountUsername     = "tfacctest"
+)
+
+func TestAccResourceVSphereVcenterLocalAccount_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVSphereVcenterLocalAccountDestroy(vcenterLocalAccountUsername),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereVcenterLocalAccountConfig("operator", 90),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereVcenterLocalAccountValidation(vcenterLocalAccountResourceName, true),
+					resource.TestCheckResourceAttr(vcenterLocalAccountResourceName, "max_days_without_password_change", "90"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereVcenterLocalAccountConfig("admin", 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereVcenterLocalAccountValidation(vcenterLocalAccountResourceName, true),
+					resource.TestCheckResourceAttr(vcenterLocalAccountResourceName, "max_days_without_password_change", "60"),
+				),
+			},
+			{
+				ResourceName:            vcenterLocalAccountResourceName,
+				Config:                  testAccResourceVSphereVcenterLocalAccountConfig("admin", 60),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccVSphereVcenterLocalAccountDestroy(username string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		exists, err := vsphereVcenterLocalAccountExists(testAccProvider.Meta(), username)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("local account '%s' should have been deleted", username)
+		}
+
+		return nil
+	}
+}
+
+func testAccVSphereVcenterLocalAccountValidation(resourceName string, shouldExist bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("%s key not found on the server", resourceName)
+		}
+
+		exists, err := vsphereVcenterLocalAccountExists(testAccProvider.Meta(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if exists != shouldExist {
+			return fmt.Errorf("local account '%s' existence should be %t; got %t", rs.Primary.ID, shouldExist, exists)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereVcenterLocalAccountConfig(role string, maxDays int) string {
+	return fmt.Sprintf(`
+	resource "vsphere_vcenter_local_account" "account" {
+		username                         = "%s"
+		password                         = "VMware1!VMware1!"
+		full_name                        = "Terraform Acceptance Test"
+		email                            = "tfacctest@example.com"
+		roles                            = ["%s"]
+		max_days_without_password_change = %d
+	}
+	`,
+		vcenterLocalAccountUsername,
+		role,
+		maxDays,
+	)
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+	"github.com/vmware/govmomi/vapi/appliance/networking"
+)
+
+const (
+	vsphereVcenterProxyID = "tf-vcenter-proxy"
+
+	proxyPath   = "/appliance/networking/proxy"
+	noProxyPath = "/appliance/networking/noproxy"
+)
+
+// vcenterProxyProtocols are the protocols the appliance can proxy which
+// also double as the attribute names for each proxy configuration
+var vcenterProxyProtocols = []string{"http", "https", "ftp"}
+
+func resourceVSphereVcenterProxy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereVcenterProxyCreate,
+		Read:   resourceVSphereVcenterProxyRead,
+		Update: resourceVSphereVcenterProxyUpdate,
+		Delete: resourceVSphereVcenterProxyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceVSphereVcenterProxyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"http":  vcenterProxySchema("http"),
+			"https": vcenterProxySchema("https"),
+			"ftp":   vcenterProxySchema("ftp"),
+			"no_proxy": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Servers that should not be proxied",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func vcenterProxySchema(protocol string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: fmt.Sprintf("Proxy configuration for %s traffic", protocol),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"server": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "URL of the proxy server",
+				},
+				"port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "Port of the proxy server",
+					ValidateFunc: validation.IsPortNumber,
+				},
+				"username": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Username to authenticate against the proxy server",
+				},
+				"password": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Password to authenticate against the proxy server",
+				},
+				"enabled": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Whether the proxy is enabled",
+				},
+			},
+		},
+	}
+}
+
+func resourceVSphereVcenterProxyCreate(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterProxyUpdate(d, meta, false)
+	if err != nil {
+		return fmt.Errorf("error creating proxy configurations: %s", err)
+	}
+
+	d.SetId(vsphereVcenterProxyID)
+	return nil
+}
+
+func resourceVSphereVcenterProxyRead(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterProxyRead(d, meta)
+	if err != nil {
+		return fmt.Errorf("error retrieving proxy configuration info in read function: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterProxyUpdate(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterProxyUpdate(d, meta, false)
+	if err != nil {
+		return fmt.Errorf("error updating proxy configurations: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterProxyDelete(d *schema.ResourceData, meta interface{}) error {
+	err := vsphereVcenterProxyUpdate(d, meta, true)
+	if err != nil {
+		return fmt.Errorf("error deleting proxy configurations: %s", err)
+	}
+
+	return nil
+}
+
+func resourceVSphereVcenterProxyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != vsphereVcenterProxyID {
+		return nil, fmt.Errorf("invalid import.  Import should simply be '%s'", vsphereVcenterProxyID)
+	}
+
+	err := vsphereVcenterProxyRead(d, meta)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving proxy configuration info in import function: %s", err)
+	}
+
+	d.SetId(vsphereVcenterProxyID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func vsphereVcenterProxyRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultAPITimeout)
+	defer cancel()
+
+	client := meta.(*Client).restClient
+	pm := networking.NewManager(client)
+
+	proxies, err := pm.ProxyList(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving proxy configuration: %s", err)
+	}
+
+	noProxy, err := pm.NoProxy(ctx)
+	if err != nil {
+		return fmt.Errorf("error retrieving no proxy configuration: %s", err)
+	}
+
+	for protocol, proxy := range map[string]networking.Proxy{
+		"http":  proxies.Http,
+		"https": proxies.Https,
+		"ftp":   proxies.Ftp,
+	} {
+		// An unconfigured proxy is returned with an empty server so treat
+		// that the same as the block not being set
+		if proxy.Server == "" {
+			d.Set(protocol, nil)
+			continue
+		}
+
+		// The password is never returned by the api so keep what is in state
+		password := d.Get(protocol + ".0.password")
+		if password == nil {
+			password = ""
+		}
+
+		d.Set(protocol, []interface{}{
+			map[string]interface{}{
+				"server":   proxy.Server,
+				"port":     proxy.Port,
+				"username": proxy.Username,
+				"password": password,
+				"enabled":  proxy.Enabled,
+			},
+		})
+	}
+
+	d.Set("no_proxy", noProxy)
+	return nil
+}
+
+func vsphereVcenterProxyUpdate(d *schema.ResourceData, meta interface{}, isDelete bool) error {
+	client := meta.(*Client).restClient
+
+	for _, protocol := range vcenterProxyProtocols {
+		if !isDelete && !d.IsNewResource() && !d.HasChange(protocol) {
+			continue
+		}
+
+		proxies := d.Get(protocol).([]interface{})
+
+		if isDelete || len(proxies) == 0 {
+			if _, err := viapi.RestRequest[[]interface{}](
+				client,
+				http.MethodDelete,
+				proxyPath+"/"+protocol,
+				nil,
+			); err != nil {
+				return fmt.Errorf("error deleting %s proxy configuration: %s", protocol, err)
+			}
+
+			continue
+		}
+
+		if _, err := viapi.RestRequest[[]interface{}](
+			client,
+			http.MethodPut,
+			proxyPath+"/"+protocol,
+			map[string]interface{}{
+				"config": proxies[0],
+			},
+		); err != nil {
+			return fmt.Errorf("error updating %s proxy configuration: %s", protocol, err)
+		}
+	}
+
+	noProxy := []interface{}{}
+
+	if !isDelete {
+		noProxy = d.Get("no_proxy").(*schema.Set).List()
+	}
+
+	if _, err := viapi.RestRequest[[]interface{}](
+		client,
+		http.MethodPut,
+		noProxyPath,
+		map[string]interface{}{
+			"servers": noProxy,
+		},
+	); err != nil {
+		return fmt.Errorf("error updating no proxy configuration: %s", err)
+	}
+
+	return nil
+}
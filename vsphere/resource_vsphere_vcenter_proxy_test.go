@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/govmomi/vapi/appliance/networking"
+)
+
+func TestAccResourceVSphereVcenterProxy_basic(t *testing.T) {
+	resourceName := "vsphere_vcenter_proxy.proxy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccVSphereVcenterProxyValidation(""),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereVcenterProxyConfig("http://proxy.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereVcenterProxyValidation("http://proxy.example.com"),
+				),
+			},
+			{
+				Config: testAccResourceVSphereVcenterProxyConfig("http://proxy2.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccVSphereVcenterProxyValidation("http://proxy2.example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				Config:            testAccResourceVSphereVcenterProxyConfig("http://proxy2.example.com"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVSphereVcenterProxyValidation(server string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*Client).restClient
+
+		proxies, err := networking.NewManager(client).ProxyList(context.Background())
+		if err != nil {
+			return err
+		}
+
+		if proxies.Https.Server != server {
+			return fmt.Errorf("https proxy server should be '%s'; got '%s'", server, proxies.Https.Server)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereVcenterProxyConfig(server string) string {
+	return fmt.Sprintf(`
+	resource "vsphere_vcenter_proxy" "proxy" {
+		https {
+			server = "%s"
+			port   = 3128
+		}
+		no_proxy = ["localhost", "127.0.0.1"]
+	}
+	`,
+		server,
+	)
+}
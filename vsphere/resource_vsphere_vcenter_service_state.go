@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/vcenterservicestate"
+)
+
+const (
+	vsphereVcenterServiceStateID = "tf-vcenter-service-state"
+)
+
+func resourceVSphereVcenterServiceState() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceVSphereVcenterServiceStateCreate,
+		Read:          resourceVSphereVcenterServiceStateRead,
+		Update:        resourceVSphereVcenterServiceStateUpdate,
+		Delete:        resourceVSphereVcenterServiceStateDelete,
+		CustomizeDiff: resourceVSphereVcenterServiceStateCustomDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereVcenterServiceStateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The service state object",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key of the vcenter service such as 'vmware-vpostgres' or 'vsphere-ui'",
+						},
+						"startup_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      vcenterservicestate.StartupTypeAutomatic,
+							Description:  "The startup type of the service.  Valid options are 'AUTOMATIC', 'MANUAL' or 'DISABLED'",
+							ValidateFunc: validation.StringInSlice(vcenterservicestate.StartupTypeList, false),
+						},
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      vcenterservicestate.StateStarted,
+							Description:  "The desired running state of the service.  Valid options are 'STARTED' or 'STOPPED'",
+							ValidateFunc: validation.StringInSlice(vcenterservicestate.StateList, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVSphereVcenterServiceStateRead(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] entering resource_vsphere_vcenter_service_state read function")
+
+	client := meta.(*Client).restClient
+	srvs := d.Get("service").(*schema.Set).List()
+	updatedList := make([]interface{}, 0, len(srvs))
+
+	for _, v := range srvs {
+		srv := v.(map[string]interface{})
+
+		info, err := vcenterservicestate.GetService(client, srv["key"].(string))
+		if err != nil {
+			return err
+		}
+
+		updatedList = append(updatedList, map[string]interface{}{
+			"key":          srv["key"],
+			"startup_type": info["startup_type"],
+			"state":        info["state"],
+		})
+	}
+
+	d.Set("service", updatedList)
+
+	return nil
+}
+
+func resourceVSphereVcenterServiceStateCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] entering resource_vsphere_vcenter_service_state create function")
+
+	client := meta.(*Client).restClient
+
+	for _, v := range d.Get("service").(*schema.Set).List() {
+		srv := v.(map[string]interface{})
+
+		if err := vcenterservicestate.SetServiceState(client, srv, defaultAPITimeout); err != nil {
+			return fmt.Errorf("error trying to create service state '%s': %s", srv["key"], err)
+		}
+	}
+
+	d.SetId(vsphereVcenterServiceStateID)
+
+	return nil
+}
+
+func resourceVSphereVcenterServiceStateUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] entering resource_vsphere_vcenter_service_state update function")
+
+	client := meta.(*Client).restClient
+
+	// Services removed from the set are simply no longer managed as stopping
+	// core vcenter services on removal could take down the appliance
+	for _, v := range d.Get("service").(*schema.Set).List() {
+		srv := v.(map[string]interface{})
+
+		if err := vcenterservicestate.SetServiceState(client, srv, defaultAPITimeout); err != nil {
+			return fmt.Errorf("error trying to update service state '%s': %s", srv["key"], err)
+		}
+	}
+
+	return nil
+}
+
+// resourceVSphereVcenterServiceStateDelete only removes the resource from
+// state for the same reason removed services are left as is on update
+func resourceVSphereVcenterServiceStateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] entering resource_vsphere_vcenter_service_state delete function")
+
+	return nil
+}
+
+func resourceVSphereVcenterServiceStateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Printf("[DEBUG] entering resource vcenter service state import function")
+
+	if d.Id() != vsphereVcenterServiceStateID {
+		return nil, fmt.Errorf("invalid import.  Import should simply be '%s'", vsphereVcenterServiceStateID)
+	}
+
+	services, err := vcenterservicestate.GetServices(meta.(*Client).restClient)
+	if err != nil {
+		return nil, err
+	}
+
+	srvs := make([]interface{}, 0, len(services))
+
+	for key, info := range services {
+		if info["state"] == vcenterservicestate.StateStarted {
+			srvs = append(srvs, map[string]interface{}{
+				"key":          key,
+				"startup_type": info["startup_type"],
+				"state":        info["state"],
+			})
+		}
+	}
+
+	d.SetId(vsphereVcenterServiceStateID)
+	d.Set("service", srvs)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVSphereVcenterServiceStateCustomDiff(ctx context.Context, rd *schema.ResourceDiff, meta interface{}) error {
+	srvs := rd.Get("service").(*schema.Set).List()
+	trackerMap := map[string]bool{}
+
+	for _, val := range srvs {
+		srv := val.(map[string]interface{})
+
+		if _, ok := trackerMap[srv["key"].(string)]; ok {
+			return fmt.Errorf("duplicate values for 'key' attribute in 'service' resource is not allowed")
+		}
+		trackerMap[srv["key"].(string)] = true
+	}
+
+	return nil
+}
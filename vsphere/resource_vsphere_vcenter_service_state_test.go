@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/vcenterservicestate"
+)
+
+// vcenterServiceStateTestKey is a non critical service that can safely be
+// stopped and started during tests
+const vcenterServiceStateTestKey = "vmware-imagebuilder"
+
+func TestAccResourceVSphereVcenterServiceState_basic(t *testing.T) {
+	resourceName := "vsphere_vcenter_service_state.srv"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereVcenterServiceStateConfig(vcenterservicestate.StartupTypeManual, vcenterservicestate.StateStarted),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceVSphereVcenterServiceStateValidate(vcenterservicestate.StartupTypeManual, vcenterservicestate.StateStarted),
+				),
+			},
+			{
+				Config: testAccResourceVSphereVcenterServiceStateConfig(vcenterservicestate.StartupTypeManual, vcenterservicestate.StateStopped),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceVSphereVcenterServiceStateValidate(vcenterservicestate.StartupTypeManual, vcenterservicestate.StateStopped),
+				),
+			},
+			{
+				Config: testAccResourceVSphereVcenterServiceStateConfig(vcenterservicestate.StartupTypeManual, vcenterservicestate.StateStopped),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", vsphereVcenterServiceStateID),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereVcenterServiceStateValidate(startupType, state string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		info, err := vcenterservicestate.GetService(testAccProvider.Meta().(*Client).restClient, vcenterServiceStateTestKey)
+		if err != nil {
+			return err
+		}
+
+		if info["startup_type"] != startupType {
+			return fmt.Errorf("startup type should be '%s'; got '%s'", startupType, info["startup_type"])
+		}
+
+		if info["state"] != state {
+			return fmt.Errorf("state should be '%s'; got '%s'", state, info["state"])
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereVcenterServiceStateConfig(startupType, state string) string {
+	return fmt.Sprintf(`
+	resource "vsphere_vcenter_service_state" "srv" {
+		service {
+			key          = "%s"
+			startup_type = "%s"
+			state        = "%s"
+		}
+	}
+	`,
+		vcenterServiceStateTestKey,
+		startupType,
+		state,
+	)
+}
@@ -4,15 +4,24 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
 	"github.com/vmware/govmomi/vapi/appliance/logging"
+	"github.com/vmware/govmomi/vapi/rest"
 )
 
 const (
 	vAppSyslogID = "tf-vcenter-syslog"
+
+	loggingForwardingPath = "/appliance/logging/forwarding"
+
+	// loggingForwardingStateUp is the state reported by the forwarding test
+	// when a log server could be reached.  Other states are DOWN and UNKNOWN
+	loggingForwardingStateUp = "UP"
 )
 
 func resourceVSphereVcenterSyslog() *schema.Resource {
@@ -57,17 +66,40 @@ func resourceVSphereVcenterSyslog() *schema.Resource {
 					},
 				},
 			},
+			"ca_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "PEM encoded ca certificate of the log servers to add to the vcenter trusted root chains when forwarding over tls",
+				ValidateFunc: certificate.ValidatePEM(),
+			},
+			"ca_certificate_chain_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Id of the trusted root chain created for ca_certificate",
+			},
+			"test_on_apply": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send a test message to every log server after applying and fail if any of them can't be reached",
+			},
 		},
 	}
 }
 
 func resourceVSphereVcenterSyslogCreate(d *schema.ResourceData, meta interface{}) error {
 	err := vsphereVCenterSyslogForwardingUpdate(d, meta, true)
+
+	// Keep the trusted root chain in state when a later step such as the
+	// forwarding test fails so it is removed again instead of orphaned
+	if err == nil || d.Get("ca_certificate_chain_id").(string) != "" {
+		d.SetId(vAppSyslogID)
+	}
+
 	if err != nil {
 		return fmt.Errorf("error creating syslog configurations: %s", err)
 	}
 
-	d.SetId(vAppSyslogID)
 	return nil
 }
 
@@ -135,6 +167,19 @@ func vsphereVcenterSyslogForwardingRead(d *schema.ResourceData, meta interface{}
 	}
 
 	d.Set("log_server", logList)
+
+	if chainID, ok := d.GetOk("ca_certificate_chain_id"); ok {
+		found, err := vsphereVcenterTrustedRootChainExists(client, chainID.(string))
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			d.Set("ca_certificate", "")
+			d.Set("ca_certificate_chain_id", "")
+		}
+	}
+
 	return nil
 }
 
@@ -142,6 +187,10 @@ func vsphereVCenterSyslogForwardingUpdate(d *schema.ResourceData, meta interface
 	client := meta.(*Client).restClient
 	var reqBody map[string]interface{}
 
+	if err := vsphereVcenterSyslogCACertificateUpdate(d, client, isUpdate); err != nil {
+		return err
+	}
+
 	if isUpdate {
 		reqBody = map[string]interface{}{
 			"cfg_list": d.Get("log_server").(*schema.Set).List(),
@@ -152,10 +201,86 @@ func vsphereVCenterSyslogForwardingUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	_, err := viapi.RestRequest[[]interface{}](client, http.MethodPut, "/appliance/logging/forwarding", reqBody)
+	_, err := viapi.RestRequest[[]interface{}](client, http.MethodPut, loggingForwardingPath, reqBody)
 	if err != nil {
 		return fmt.Errorf("error on syslog update request: %s", err)
 	}
 
+	if isUpdate && d.Get("test_on_apply").(bool) {
+		return vsphereVcenterSyslogForwardingTest(client)
+	}
+
+	return nil
+}
+
+// vsphereVcenterSyslogCACertificateUpdate replaces the trusted root chain
+// created for ca_certificate when it changes and removes it on delete
+func vsphereVcenterSyslogCACertificateUpdate(d *schema.ResourceData, client *rest.Client, isUpdate bool) error {
+	if isUpdate && !d.HasChange("ca_certificate") {
+		return nil
+	}
+
+	if chainID := d.Get("ca_certificate_chain_id").(string); chainID != "" {
+		found, err := vsphereVcenterTrustedRootChainExists(client, chainID)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			if err = vsphereVcenterTrustedRootChainDelete(client, chainID); err != nil {
+				return err
+			}
+		}
+
+		d.Set("ca_certificate_chain_id", "")
+	}
+
+	caPEM := d.Get("ca_certificate").(string)
+	if !isUpdate || caPEM == "" {
+		return nil
+	}
+
+	chainID, err := vsphereVcenterTrustedRootChainCreate(client, []string{caPEM})
+	if err != nil {
+		return err
+	}
+
+	d.Set("ca_certificate_chain_id", chainID)
+	return nil
+}
+
+// vsphereVcenterSyslogForwardingTest sends a test message to every configured
+// log server and returns an error listing the ones that are not reachable
+func vsphereVcenterSyslogForwardingTest(client *rest.Client) error {
+	reqBody := map[string]interface{}{
+		"send_test_message": true,
+	}
+
+	results, err := viapi.RestRequest[[]interface{}](client, http.MethodPost, loggingForwardingPath+"?~action=test", reqBody)
+	if err != nil {
+		return fmt.Errorf("error testing syslog forwarding: %s", err)
+	}
+
+	failed := make([]string, 0)
+
+	for _, v := range results {
+		result := v.(map[string]interface{})
+
+		if result["state"] == loggingForwardingStateUp {
+			continue
+		}
+
+		msg := fmt.Sprintf("%s: %s", result["hostname"], result["state"])
+		if detail, ok := result["message"].(map[string]interface{}); ok {
+			msg = fmt.Sprintf("%s (%s)", msg, detail["default_message"])
+		}
+
+		failed = append(failed, msg)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("syslog forwarding test failed for log servers: %s", strings.Join(failed, ", "))
+	}
+
 	return nil
 }
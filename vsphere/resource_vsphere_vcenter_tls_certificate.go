@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+)
+
+const (
+	vsphereVcenterTLSCertificateID = "tf-vcenter-tls-certificate"
+
+	tlsCertificatePath = "/vcenter/certificate-management/vcenter/tls"
+)
+
+func resourceVSphereVcenterTLSCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereVcenterTLSCertificateCreate,
+		Read:   resourceVSphereVcenterTLSCertificateRead,
+		Update: resourceVSphereVcenterTLSCertificateUpdate,
+		Delete: resourceVSphereVcenterTLSCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereVcenterTLSCertificateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "PEM encoded machine ssl certificate",
+				ValidateFunc: certificate.ValidatePEM(),
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of the certificate.  Not needed when the certificate was signed from a csr generated by vcenter",
+			},
+			"root_certificate": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "PEM encoded root certificate of the ca that signed the certificate when it is not already trusted by vcenter",
+				ValidateFunc: certificate.ValidatePEM(),
+			},
+			"thumbprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-1 thumbprint of the certificate",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Serial number of the certificate",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject distinguished name of the certificate",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Issuer distinguished name of the certificate",
+			},
+			"valid_from": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the certificate became valid in RFC3339 format",
+			},
+			"valid_to": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the certificate expires in RFC3339 format",
+			},
+		},
+	}
+}
+
+func resourceVSphereVcenterTLSCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] replacing vcenter machine ssl certificate")
+
+	if err := vsphereVcenterTLSCertificateUpdate(d, meta); err != nil {
+		return fmt.Errorf("error creating vcenter tls certificate: %s", err)
+	}
+
+	d.SetId(vsphereVcenterTLSCertificateID)
+	return nil
+}
+
+func resourceVSphereVcenterTLSCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	certPEM, err := vsphereVcenterTLSCertificateGet(meta)
+	if err != nil {
+		return fmt.Errorf("error retrieving vcenter tls certificate in read function: %s", err)
+	}
+
+	// Only replace the certificate in state when vcenter is serving a different
+	// one, not when it is the same certificate in a different encoding
+	if !certificate.Equal(d.Get("certificate").(string), certPEM) {
+		d.Set("certificate", certPEM)
+	}
+
+	return flattenVcenterTLSCertificate(d, certPEM)
+}
+
+func resourceVSphereVcenterTLSCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] replacing vcenter machine ssl certificate")
+
+	if err := vsphereVcenterTLSCertificateUpdate(d, meta); err != nil {
+		return fmt.Errorf("error updating vcenter tls certificate: %s", err)
+	}
+
+	return nil
+}
+
+// resourceVSphereVcenterTLSCertificateDelete only removes the resource from
+// state as vcenter always needs a machine ssl certificate
+func resourceVSphereVcenterTLSCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] removing vcenter tls certificate from state")
+	return nil
+}
+
+func resourceVSphereVcenterTLSCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() != vsphereVcenterTLSCertificateID {
+		return nil, fmt.Errorf("invalid import.  Import should simply be '%s'", vsphereVcenterTLSCertificateID)
+	}
+
+	certPEM, err := vsphereVcenterTLSCertificateGet(meta)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving vcenter tls certificate in import function: %s", err)
+	}
+
+	d.Set("certificate", certPEM)
+	if err = flattenVcenterTLSCertificate(d, certPEM); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// vsphereVcenterTLSCertificateUpdate replaces the machine ssl certificate.
+// Vcenter restarts its services after the replacement so the certificate
+// attributes are set from config instead of being read back
+func vsphereVcenterTLSCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	certPEM := d.Get("certificate").(string)
+	spec := map[string]interface{}{
+		"cert": certPEM,
+	}
+
+	if v, ok := d.GetOk("private_key"); ok {
+		spec["key"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("root_certificate"); ok {
+		spec["root_cert"] = v.(string)
+	}
+
+	reqBody := map[string]interface{}{
+		"spec": spec,
+	}
+
+	_, err := viapi.RestRequest[map[string]interface{}](meta.(*Client).restClient, http.MethodPut, tlsCertificatePath, reqBody)
+	if err != nil {
+		return err
+	}
+
+	return flattenVcenterTLSCertificate(d, certPEM)
+}
+
+func vsphereVcenterTLSCertificateGet(meta interface{}) (string, error) {
+	res, err := viapi.RestRequest[map[string]interface{}](meta.(*Client).restClient, http.MethodGet, tlsCertificatePath, nil)
+	if err != nil {
+		return "", err
+	}
+
+	certPEM, _ := res["cert"].(string)
+	return certPEM, nil
+}
+
+func flattenVcenterTLSCertificate(d *schema.ResourceData, certPEM string) error {
+	cert, err := certificate.Parse(certPEM)
+	if err != nil {
+		return fmt.Errorf("error parsing vcenter tls certificate: %s", err)
+	}
+
+	d.Set("thumbprint", certificate.Thumbprint(cert))
+	d.Set("serial_number", cert.SerialNumber.String())
+	d.Set("subject", cert.Subject.String())
+	d.Set("issuer", cert.Issuer.String())
+	d.Set("valid_from", cert.NotBefore.UTC().Format(time.RFC3339))
+	d.Set("valid_to", cert.NotAfter.UTC().Format(time.RFC3339))
+	return nil
+}
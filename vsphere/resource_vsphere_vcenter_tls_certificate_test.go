@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
+)
+
+const (
+	vcenterTLSCertificateResourceName = "vsphere_vcenter_tls_certificate.tls"
+)
+
+func TestAccResourceVSphereVcenterTLSCertificate_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccCheckEnvVariablesF(t, []string{"VSPHERE_TLS_CERTIFICATE_FILE", "VSPHERE_TLS_PRIVATE_KEY_FILE"})
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereVcenterTLSCertificateConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceVSphereVcenterTLSCertificateValidate(vcenterTLSCertificateResourceName),
+					resource.TestCheckResourceAttrSet(vcenterTLSCertificateResourceName, "thumbprint"),
+					resource.TestCheckResourceAttrSet(vcenterTLSCertificateResourceName, "valid_to"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereVcenterTLSCertificateValidate(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("'%s' key not found on the server", name)
+		}
+
+		certPEM, err := vsphereVcenterTLSCertificateGet(testAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		if !certificate.Equal(certPEM, rs.Primary.Attributes["certificate"]) {
+			return fmt.Errorf("vcenter is not serving the certificate in state")
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereVcenterTLSCertificateConfig() string {
+	return fmt.Sprintf(`
+	resource "vsphere_vcenter_tls_certificate" "tls" {
+		certificate = file("%s")
+		private_key = file("%s")
+	}
+	`,
+		os.Getenv("VSPHERE_TLS_CERTIFICATE_FILE"),
+		os.Getenv("VSPHERE_TLS_PRIVATE_KEY_FILE"),
+	)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+)
+
+const (
+	vsphereVcenterTLSCSRID = "tf-vcenter-tls-csr"
+
+	tlsCSRPath = "/vcenter/certificate-management/vcenter/tls-csr"
+)
+
+func resourceVSphereVcenterTLSCSR() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereVcenterTLSCSRCreate,
+		Read:   resourceVSphereVcenterTLSCSRRead,
+		Delete: resourceVSphereVcenterTLSCSRDelete,
+
+		Schema: map[string]*schema.Schema{
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      2048,
+				Description:  "Size in bits of the private key vcenter generates for the csr",
+				ValidateFunc: validation.IntInSlice([]int{2048, 3072, 4096}),
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Common name of the certificate.  Defaults to the vcenter hostname",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Organization of the certificate subject",
+			},
+			"organization_unit": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Organization unit of the certificate subject",
+			},
+			"locality": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Locality of the certificate subject",
+			},
+			"state_or_province": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "State or province of the certificate subject",
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Two letter country code of the certificate subject",
+			},
+			"email_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Email address of the certificate subject",
+			},
+			"subject_alt_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Subject alternative names of the certificate",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM encoded certificate signing request",
+			},
+		},
+	}
+}
+
+// resourceVSphereVcenterTLSCSRCreate generates the csr once, as vcenter
+// replaces its pending private key every time a csr is generated
+func resourceVSphereVcenterTLSCSRCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] generating vcenter tls csr")
+
+	spec := map[string]interface{}{
+		"key_size":          d.Get("key_size").(int),
+		"organization":      d.Get("organization").(string),
+		"organization_unit": d.Get("organization_unit").(string),
+		"locality":          d.Get("locality").(string),
+		"state_or_province": d.Get("state_or_province").(string),
+		"country":           d.Get("country").(string),
+		"email_address":     d.Get("email_address").(string),
+	}
+
+	if v, ok := d.GetOk("common_name"); ok {
+		spec["common_name"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("subject_alt_names"); ok {
+		spec["subject_alt_name"] = v.([]interface{})
+	}
+
+	reqBody := map[string]interface{}{
+		"spec": spec,
+	}
+
+	res, err := viapi.RestRequest[map[string]interface{}](meta.(*Client).restClient, http.MethodPost, tlsCSRPath, reqBody)
+	if err != nil {
+		return fmt.Errorf("error generating vcenter tls csr: %s", err)
+	}
+
+	d.SetId(vsphereVcenterTLSCSRID)
+	d.Set("csr", res["csr"])
+	return nil
+}
+
+// resourceVSphereVcenterTLSCSRRead keeps the csr in state as vcenter can not
+// return a csr it generated earlier
+func resourceVSphereVcenterTLSCSRRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+// resourceVSphereVcenterTLSCSRDelete only removes the csr from state
+func resourceVSphereVcenterTLSCSRDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] removing vcenter tls csr from state")
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceVSphereVcenterTLSCSR_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereVcenterTLSCSRConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"vsphere_vcenter_tls_csr.csr",
+						"csr",
+						regexp.MustCompile("BEGIN CERTIFICATE REQUEST"),
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereVcenterTLSCSRConfig() string {
+	return `
+	resource "vsphere_vcenter_tls_csr" "csr" {
+		organization      = "Example"
+		organization_unit = "IT"
+		locality          = "Palo Alto"
+		state_or_province = "California"
+		country           = "US"
+		email_address     = "admin@example.com"
+	}
+	`
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/certificate"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/viapi"
+	"github.com/vmware/govmomi/vapi/rest"
+)
+
+const (
+	trustedRootChainsPath = "/vcenter/certificate-management/vcenter/trusted-root-chains"
+)
+
+func resourceVSphereVcenterTrustedRootChain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVSphereVcenterTrustedRootChainCreate,
+		Read:   resourceVSphereVcenterTrustedRootChainRead,
+		Delete: resourceVSphereVcenterTrustedRootChainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVSphereVcenterTrustedRootChainImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_chain": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "PEM encoded certificates of the chain, starting with the root certificate",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: certificate.ValidatePEM(),
+				},
+			},
+			"valid_from": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the most recently issued certificate of the chain became valid in RFC3339 format",
+			},
+			"valid_to": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the first certificate of the chain to expire expires in RFC3339 format",
+			},
+		},
+	}
+}
+
+func resourceVSphereVcenterTrustedRootChainCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] creating vcenter trusted root chain")
+
+	chain := structure.SliceInterfacesToStrings(d.Get("certificate_chain").([]interface{}))
+
+	chainID, err := vsphereVcenterTrustedRootChainCreate(meta.(*Client).restClient, chain)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(chainID)
+	return resourceVSphereVcenterTrustedRootChainRead(d, meta)
+}
+
+func resourceVSphereVcenterTrustedRootChainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Client).restClient
+
+	found, err := vsphereVcenterTrustedRootChainExists(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		log.Printf("[DEBUG] vcenter trusted root chain '%s' not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	chain, err := vsphereVcenterTrustedRootChainGet(client, d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Keep the certificates from config when vcenter only returns them in a
+	// different encoding so that no diff is shown
+	current := structure.SliceInterfacesToStrings(d.Get("certificate_chain").([]interface{}))
+	if len(current) != len(chain) {
+		current = chain
+	} else {
+		for i := range chain {
+			if !certificate.Equal(current[i], chain[i]) {
+				current = chain
+				break
+			}
+		}
+	}
+
+	validFrom, validTo, err := certificateChainValidity(chain)
+	if err != nil {
+		return fmt.Errorf("error parsing vcenter trusted root chain '%s': %s", d.Id(), err)
+	}
+
+	d.Set("certificate_chain", current)
+	d.Set("valid_from", validFrom)
+	d.Set("valid_to", validTo)
+	return nil
+}
+
+func resourceVSphereVcenterTrustedRootChainDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] deleting vcenter trusted root chain '%s'", d.Id())
+
+	return vsphereVcenterTrustedRootChainDelete(meta.(*Client).restClient, d.Id())
+}
+
+func resourceVSphereVcenterTrustedRootChainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	found, err := vsphereVcenterTrustedRootChainExists(meta.(*Client).restClient, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, fmt.Errorf("vcenter trusted root chain '%s' does not exist", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// certificateChainValidity returns the window in which every certificate of
+// the PEM encoded chain is valid, formatted as RFC3339
+func certificateChainValidity(chain []string) (string, string, error) {
+	var validFrom, validTo time.Time
+
+	for _, certPEM := range chain {
+		cert, err := certificate.Parse(certPEM)
+		if err != nil {
+			return "", "", err
+		}
+
+		if validFrom.IsZero() || cert.NotBefore.After(validFrom) {
+			validFrom = cert.NotBefore
+		}
+
+		if validTo.IsZero() || cert.NotAfter.Before(validTo) {
+			validTo = cert.NotAfter
+		}
+	}
+
+	return validFrom.UTC().Format(time.RFC3339), validTo.UTC().Format(time.RFC3339), nil
+}
+
+func vsphereVcenterTrustedRootChainCreate(client *rest.Client, chain []string) (string, error) {
+	reqBody := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"cert_chain": map[string]interface{}{
+				"cert_chain": chain,
+			},
+		},
+	}
+
+	chainID, err := viapi.RestRequest[string](client, http.MethodPost, trustedRootChainsPath, reqBody)
+	if err != nil {
+		return "", fmt.Errorf("error adding trusted root chain: %s", err)
+	}
+
+	return chainID, nil
+}
+
+func vsphereVcenterTrustedRootChainGet(client *rest.Client, chainID string) ([]string, error) {
+	res, err := viapi.RestRequest[map[string]interface{}](client, http.MethodGet, trustedRootChainsPath+"/"+chainID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving trusted root chain '%s': %s", chainID, err)
+	}
+
+	chain := make([]string, 0)
+
+	if certChain, ok := res["cert_chain"].(map[string]interface{}); ok {
+		if certs, ok := certChain["cert_chain"].([]interface{}); ok {
+			chain = structure.SliceInterfacesToStrings(certs)
+		}
+	}
+
+	return chain, nil
+}
+
+func vsphereVcenterTrustedRootChainDelete(client *rest.Client, chainID string) error {
+	_, err := viapi.RestRequest[map[string]interface{}](client, http.MethodDelete, trustedRootChainsPath+"/"+chainID, nil)
+	if err != nil {
+		return fmt.Errorf("error removing trusted root chain '%s': %s", chainID, err)
+	}
+
+	return nil
+}
+
+func vsphereVcenterTrustedRootChainExists(client *rest.Client, chainID string) (bool, error) {
+	chains, err := viapi.RestRequest[[]interface{}](client, http.MethodGet, trustedRootChainsPath, nil)
+	if err != nil {
+		return false, fmt.Errorf("error retrieving trusted root chains: %s", err)
+	}
+
+	for _, v := range chains {
+		if v.(map[string]interface{})["chain"] == chainID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package vsphere
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	vcenterTrustedRootChainResourceName = "vsphere_vcenter_trusted_root_chain.chain"
+)
+
+func TestAccResourceVSphereVcenterTrustedRootChain_basic(t *testing.T) {
+	caPEM := testAccGenerateCACertificatePEM(t, "tf-acc-test-ca")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccResourceVSphereVcenterTrustedRootChainExists(vcenterTrustedRootChainResourceName, false),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereVcenterTrustedRootChainConfig(caPEM),
+				Check: resource.ComposeTestCheckFunc(
+					testAccResourceVSphereVcenterTrustedRootChainExists(vcenterTrustedRootChainResourceName, true),
+					resource.TestCheckResourceAttrSet(vcenterTrustedRootChainResourceName, "valid_to"),
+				),
+			},
+			{
+				ResourceName:      vcenterTrustedRootChainResourceName,
+				Config:            testAccResourceVSphereVcenterTrustedRootChainConfig(caPEM),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereVcenterTrustedRootChainExists(name string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("'%s' key not found on the server", name)
+		}
+
+		found, err := vsphereVcenterTrustedRootChainExists(testAccProvider.Meta().(*Client).restClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if found != expected {
+			return fmt.Errorf("expected trusted root chain '%s' to exist: %t; got: %t", rs.Primary.ID, expected, found)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceVSphereVcenterTrustedRootChainConfig(caPEM string) string {
+	return fmt.Sprintf(`
+	resource "vsphere_vcenter_trusted_root_chain" "chain" {
+		certificate_chain = [
+			<<EOT
+%sEOT
+		]
+	}
+	`,
+		caPEM,
+	)
+}
+
+// testAccGenerateCACertificatePEM generates a self signed PEM encoded ca
+// certificate for tests that need a certificate vcenter or a host will accept
+func testAccGenerateCACertificatePEM(t *testing.T, cn string) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("error generating key: %s", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("error generating certificate: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}